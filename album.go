@@ -94,6 +94,7 @@ type SavedAlbum struct {
 //
 // [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
 func (c *Client) GetAlbum(ctx context.Context, id ID, opts ...RequestOption) (*FullAlbum, error) {
+	ctx = c.withOperation(ctx, "GetAlbum")
 	spotifyURL := fmt.Sprintf("%salbums/%s", c.baseURL, id)
 
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
@@ -102,7 +103,7 @@ func (c *Client) GetAlbum(ctx context.Context, id ID, opts ...RequestOption) (*F
 
 	var a FullAlbum
 
-	err := c.get(ctx, spotifyURL, &a)
+	err := c.getItem(ctx, spotifyURL, albumBatch, id, &a, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +111,46 @@ func (c *Client) GetAlbum(ctx context.Context, id ID, opts ...RequestOption) (*F
 	return &a, nil
 }
 
+// GetAlbums gets Spotify catalog information for [multiple albums], given their
+// [Spotify ID]s.  Any number of IDs may be given; they are requested 20 at a
+// time, concurrently.  Albums are returned in the order requested.  If an album
+// is not found, that position in the result slice will be nil.  Duplicate IDs
+// result in duplicate albums in the result.
+//
+// Supported options: [Market].
+//
+// [multiple albums]: https://developer.spotify.com/documentation/web-api/reference/get-multiple-albums
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetAlbums(ctx context.Context, ids []ID, opts ...RequestOption) ([]*FullAlbum, error) {
+	ctx = c.withOperation(ctx, "GetAlbums")
+	return getSeveral[FullAlbum](ctx, c, albumBatch, ids, opts...)
+}
+
+// NewReleases gets a [list of new album releases] featured in Spotify.
+// Further pages can be fetched with [Client.NextPage].
+//
+// Supported options: [Country], [Limit], [Offset].
+//
+// [list of new album releases]: https://developer.spotify.com/documentation/web-api/reference/get-new-releases
+func (c *Client) NewReleases(ctx context.Context, opts ...RequestOption) (*SimpleAlbumPage, error) {
+	ctx = c.withOperation(ctx, "NewReleases")
+	spotifyURL := c.baseURL + "browse/new-releases"
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result struct {
+		Albums SimpleAlbumPage `json:"albums"`
+	}
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Albums, nil
+}
+
 func toStringSlice(ids []ID) []string {
 	result := make([]string, len(ids))
 	for i, str := range ids {
@@ -157,6 +198,7 @@ func (at AlbumType) encode() string {
 //
 // [tracks]: https://developer.spotify.com/documentation/web-api/reference/get-an-albums-tracks
 func (c *Client) GetAlbumTracks(ctx context.Context, id ID, opts ...RequestOption) (*SimpleTrackPage, error) {
+	ctx = c.withOperation(ctx, "GetAlbumTracks")
 	spotifyURL := fmt.Sprintf("%salbums/%s/tracks", c.baseURL, id)
 
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
@@ -164,7 +206,7 @@ func (c *Client) GetAlbumTracks(ctx context.Context, id ID, opts ...RequestOptio
 	}
 
 	var result SimpleTrackPage
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +47,22 @@ func TestFindAlbumBadID(t *testing.T) {
 	}
 }
 
+func TestFindAlbums(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/find_albums.txt")
+	defer server.Close()
+
+	albums, err := client.GetAlbums(context.Background(), []ID{"41MnTivkwTO3UUJ8DrqEJJ", "6JWc4iAiJ9FjyK0B59ABb4", "6UXCm6bOO4gFlDQZV5yL37"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(albums) != 3 {
+		t.Fatalf("Expected 3 albums, got %d", len(albums))
+	}
+	if albums[0] == nil || albums[0].Name != "The Best Of Keane (Deluxe Edition)" {
+		t.Errorf("Unexpected first album %v", albums[0])
+	}
+}
+
 func TestFindAlbumTracks(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/find_album_tracks.txt")
 	defer server.Close()
@@ -66,3 +82,23 @@ func TestFindAlbumTracks(t *testing.T) {
 		t.Error("Expected 1 track, got", len(res.Tracks))
 	}
 }
+
+func TestNewReleases(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/new_releases.txt", func(r *http.Request) {
+		if r.URL.Path != "/browse/new-releases" || r.URL.Query().Get("country") != "SE" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	albums, err := client.NewReleases(context.Background(), Country("SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int(albums.Total) != 119 || int(albums.Limit) != 20 {
+		t.Errorf("Unexpected paging information %+v", albums.basePage)
+	}
+	if albums.Albums[0].Name != "We Are One (Ole Ola) [The Official 2014 FIFA World Cup Song]" {
+		t.Errorf("Unexpected first album %s", albums.Albums[0].Name)
+	}
+}
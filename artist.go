@@ -3,6 +3,7 @@ package spotify
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -29,10 +30,11 @@ type FullArtist struct {
 
 // GetArtist gets Spotify catalog information for a single artist, given its Spotify ID.
 func (c *Client) GetArtist(ctx context.Context, id ID) (*FullArtist, error) {
+	ctx = c.withOperation(ctx, "GetArtist")
 	spotifyURL := fmt.Sprintf("%sartists/%s", c.baseURL, id)
 
 	var a FullArtist
-	err := c.get(ctx, spotifyURL, &a)
+	err := c.getItem(ctx, spotifyURL, artistBatch, id, &a)
 	if err != nil {
 		return nil, err
 	}
@@ -40,11 +42,44 @@ func (c *Client) GetArtist(ctx context.Context, id ID) (*FullArtist, error) {
 	return &a, nil
 }
 
+// GetArtists gets Spotify catalog information for several artists, given
+// their Spotify IDs.  Any number of IDs may be given; they are requested 50 at
+// a time, concurrently.  Artists are returned in the order requested.  If an
+// artist is not found, that position in the result will be nil.  Duplicate
+// IDs result in duplicate artists in the result.
+func (c *Client) GetArtists(ctx context.Context, ids []ID, opts ...RequestOption) ([]*FullArtist, error) {
+	ctx = c.withOperation(ctx, "GetArtists")
+	return getSeveral[FullArtist](ctx, c, artistBatch, ids, opts...)
+}
+
+// GetArtistTopTracks gets Spotify catalog information about an [artist's top
+// tracks] in a particular market.  It returns a maximum of 10 tracks.  The
+// market is specified as an ISO 3166-1 alpha-2 country code, or
+// [MarketFromToken].
+//
+// [artist's top tracks]: https://developer.spotify.com/documentation/web-api/reference/get-an-artists-top-tracks
+func (c *Client) GetArtistTopTracks(ctx context.Context, artistID ID, market string) ([]FullTrack, error) {
+	ctx = c.withOperation(ctx, "GetArtistTopTracks")
+	spotifyURL := fmt.Sprintf("%sartists/%s/top-tracks?market=%s", c.baseURL, artistID, url.QueryEscape(market))
+
+	var t struct {
+		Tracks []FullTrack `json:"tracks"`
+	}
+
+	err := c.get(ctx, spotifyURL, &t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Tracks, nil
+}
+
 // GetRelatedArtists gets Spotify catalog information about artists similar to a
 // given artist.  Similarity is based on analysis of the Spotify community's
 // listening history.  This function returns up to 20 artists that are considered
 // related to the specified artist.
 func (c *Client) GetRelatedArtists(ctx context.Context, id ID) ([]FullArtist, error) {
+	ctx = c.withOperation(ctx, "GetRelatedArtists")
 	spotifyURL := fmt.Sprintf("%sartists/%s/related-artists", c.baseURL, id)
 
 	var a struct {
@@ -68,6 +103,7 @@ func (c *Client) GetRelatedArtists(ctx context.Context, id ID) ([]FullArtist, er
 //
 // Supported options: [Market].
 func (c *Client) GetArtistAlbums(ctx context.Context, artistID ID, ts []AlbumType, opts ...RequestOption) (*SimpleAlbumPage, error) {
+	ctx = c.withOperation(ctx, "GetArtistAlbums")
 	spotifyURL := fmt.Sprintf("%sartists/%s/albums", c.baseURL, artistID)
 	// add optional query string if options were specified
 	values := processOptions(opts...).urlParams
@@ -86,7 +122,7 @@ func (c *Client) GetArtistAlbums(ctx context.Context, artistID ID, ts []AlbumTyp
 
 	var p SimpleAlbumPage
 
-	err := c.get(ctx, spotifyURL, &p)
+	err := c.get(ctx, spotifyURL, &p, opts...)
 	if err != nil {
 		return nil, err
 	}
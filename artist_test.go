@@ -16,7 +16,9 @@ package spotify
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -79,6 +81,22 @@ const albumsResponse = `
 	"total" : 157
 }`
 
+func TestGetArtists(t *testing.T) {
+	client, server, requests := batchTestClient()
+	defer server.Close()
+
+	artists, err := client.GetArtists(context.Background(), []ID{"a", "missing", "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(artists) != 3 || artists[0].ID != "a" || artists[1] != nil || artists[2].ID != "a" {
+		t.Errorf("Expected artist a, nil and artist a, got %v", artists)
+	}
+	if got := requests(); len(got) != 1 || got[0] != "/artists?a,missing,a" {
+		t.Errorf("Expected 1 request, got %v", got)
+	}
+}
+
 func TestFindArtist(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/find_artist.txt")
 	defer server.Close()
@@ -155,3 +173,89 @@ func TestArtistAlbumsFiltered(t *testing.T) {
 		t.Errorf("Wrong Spotify external URL: want %s, got %s\n", url, spotifyURL)
 	}
 }
+
+func TestArtistTopTracks(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/artist_top_tracks.txt", func(r *http.Request) {
+		if r.URL.Path != "/artists/43ZHCT0cAZBISjO8DG9PnE/top-tracks" || r.URL.Query().Get("market") != "SE" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	tracks, err := client.GetArtistTopTracks(context.Background(), "43ZHCT0cAZBISjO8DG9PnE", "SE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(tracks); l != 10 {
+		t.Fatalf("Got %d tracks, expected 10\n", l)
+	}
+	if n := tracks[0].Name; n != "Suspicious Minds" {
+		t.Errorf("Got %s, expected Suspicious Minds\n", n)
+	}
+}
+
+func TestArtistDiscography(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/artists/artist/albums" && r.URL.Query().Get("offset") == "":
+			if r.URL.Query().Get("include_groups") != "album,single" || r.URL.Query().Get("market") != "SE" {
+				t.Errorf("Unexpected request %s", r.URL)
+			}
+			fmt.Fprintf(w, `{"limit": 2, "offset": 0, "total": 4, "next": "%s/artists/artist/albums?offset=2&limit=2", "items": [
+				{"id": "deluxe", "name": "First (Deluxe Edition)", "album_type": "album", "release_date": "2012-05-01", "release_date_precision": "day"},
+				{"id": "single", "name": "Second", "album_type": "single", "release_date": "2011", "release_date_precision": "year"}]}`, server.URL)
+		case r.URL.Path == "/artists/artist/albums":
+			fmt.Fprint(w, `{"limit": 2, "offset": 2, "total": 4, "next": null, "items": [
+				{"id": "first", "name": "First", "album_type": "album", "release_date": "2010-03-01", "release_date_precision": "day"},
+				{"id": "remaster", "name": "First - 2020 Remaster", "album_type": "album", "release_date": "2020-01-01", "release_date_precision": "day"}]}`)
+		case r.URL.Path == "/albums":
+			if ids := r.URL.Query().Get("ids"); ids != "first,single" {
+				t.Errorf("Expected the earliest editions to be fetched, got %s", ids)
+			}
+			fmt.Fprintf(w, `{"albums": [
+				{"id": "first", "name": "First", "tracks": {"limit": 1, "offset": 0, "total": 2, "next": "%s/albums/first/tracks?offset=1&limit=1", "items": [{"name": "one"}]}},
+				{"id": "single", "name": "Second", "tracks": {"limit": 1, "offset": 0, "total": 1, "next": null, "items": [{"name": "single"}]}}]}`, server.URL)
+		case r.URL.Path == "/albums/first/tracks":
+			fmt.Fprint(w, `{"limit": 1, "offset": 1, "total": 2, "next": null, "items": [{"name": "two"}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	albums, err := client.GetArtistDiscography(context.Background(), "artist", []AlbumType{AlbumTypeAlbum, AlbumTypeSingle}, Market("SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(albums) != 2 {
+		t.Fatalf("Expected 2 albums, got %d", len(albums))
+	}
+	first := albums[0]
+	if first.ID != "first" || len(first.Editions) != 2 {
+		t.Errorf("Expected album first with 2 other editions, got %s with %d", first.ID, len(first.Editions))
+	}
+	if len(first.Tracks.Tracks) != 2 || first.Tracks.Tracks[1].Name != "two" {
+		t.Errorf("Expected every track of the album, got %+v", first.Tracks.Tracks)
+	}
+	if albums[1].ID != "single" || len(albums[1].Editions) != 0 {
+		t.Errorf("Unexpected second album %s", albums[1].ID)
+	}
+}
+
+func TestEditionKey(t *testing.T) {
+	for name, want := range map[string]string{
+		"Abbey Road":                          "abbey road",
+		"Abbey Road (Remastered)":             "abbey road",
+		"Abbey Road (Super Deluxe Edition)":   "abbey road",
+		"Abbey Road - 2019 Mix Remaster":      "abbey road",
+		"Live at Leeds (Live)":                "live at leeds (live)",
+		"Greatest Hits - Volume 2 [Deluxe]":   "greatest hits - volume 2",
+		"Songs - Expanded Edition [Explicit]": "songs",
+	} {
+		if got := editionKey(name); got != want {
+			t.Errorf("editionKey(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -102,6 +102,7 @@ type AnalysisTrack struct {
 //
 // [audio analysis]: https://developer.spotify.com/documentation/web-api/reference/get-audio-analysis
 func (c *Client) GetAudioAnalysis(ctx context.Context, id ID) (*AudioAnalysis, error) {
+	ctx = c.withOperation(ctx, "GetAudioAnalysis")
 	url := fmt.Sprintf("%saudio-analysis/%s", c.baseURL, id)
 
 	temp := AudioAnalysis{}
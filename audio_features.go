@@ -2,7 +2,9 @@ package spotify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -112,7 +114,23 @@ const (
 // high-level acoustic attributes of audio tracks.
 // Objects are returned in the order requested.  If an object
 // is not found, a nil value is returned in the appropriate position.
+//
+// When batching is enabled, lookups of a single ID are merged with those
+// made concurrently; see [WithBatching].
 func (c *Client) GetAudioFeatures(ctx context.Context, ids ...ID) ([]*AudioFeatures, error) {
+	ctx = c.withOperation(ctx, "GetAudioFeatures")
+	if len(ids) == 1 && c.batcher != nil {
+		raw, err := c.load(ctx, audioFeaturesBatch, ids[0], url.Values{})
+		if err != nil {
+			return nil, err
+		}
+		var f *AudioFeatures
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, err
+		}
+		return []*AudioFeatures{f}, nil
+	}
+
 	url := fmt.Sprintf("%saudio-features?ids=%s", c.baseURL, strings.Join(toStringSlice(ids), ","))
 
 	temp := struct {
@@ -0,0 +1,300 @@
+package spotify
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Author is an author of an audiobook.
+type Author struct {
+	// The name of the author.
+	Name string `json:"name"`
+}
+
+// Narrator is a narrator of an audiobook.
+type Narrator struct {
+	// The name of the narrator.
+	Name string `json:"name"`
+}
+
+// SimpleAudiobook contains basic data about an audiobook.
+type SimpleAudiobook struct {
+	// The authors of the audiobook.
+	Authors []Author `json:"authors"`
+
+	// A list of the countries in which the audiobook can be played,
+	// identified by their [ISO 3166-1 alpha-2] code.
+	//
+	// [ISO 3166-1 alpha-2]: http://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+	AvailableMarkets []string `json:"available_markets"`
+
+	// The copyright statements of the audiobook.
+	Copyrights []Copyright `json:"copyrights"`
+
+	// A description of the audiobook.  HTML tags are stripped away.
+	Description string `json:"description"`
+
+	// A description of the audiobook which may contain HTML tags.
+	HTMLDescription string `json:"html_description"`
+
+	// The edition of the audiobook, for example "Unabridged".
+	Edition string `json:"edition"`
+
+	// Whether or not the audiobook has explicit content
+	// (true = yes it does; false = no it does not OR unknown).
+	Explicit bool `json:"explicit"`
+
+	// Known external URLs for this audiobook.
+	ExternalURLs map[string]string `json:"external_urls"`
+
+	// A link to the Web API endpoint providing full details
+	// of the audiobook.
+	Href string `json:"href"`
+
+	// The [Spotify ID] for the audiobook.
+	//
+	// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+	ID ID `json:"id"`
+
+	// The cover art for the audiobook in various sizes,
+	// widest first.
+	Images []Image `json:"images"`
+
+	// A list of the languages used in the audiobook, identified by
+	// their [ISO 639] code.
+	//
+	// [ISO 639]: https://en.wikipedia.org/wiki/ISO_639
+	Languages []string `json:"languages"`
+
+	// The media type of the audiobook.
+	MediaType string `json:"media_type"`
+
+	// The name of the audiobook.
+	Name string `json:"name"`
+
+	// The narrators of the audiobook.
+	Narrators []Narrator `json:"narrators"`
+
+	// The publisher of the audiobook.
+	Publisher string `json:"publisher"`
+
+	// The number of chapters in the audiobook.
+	TotalChapters Numeric `json:"total_chapters"`
+
+	// The object type: "audiobook".
+	Type string `json:"type"`
+
+	// The Spotify URI for the audiobook.
+	URI URI `json:"uri"`
+}
+
+// FullAudiobook contains full data about an audiobook.
+type FullAudiobook struct {
+	SimpleAudiobook
+
+	// The chapters of the audiobook.
+	Chapters SimpleChapterPage `json:"chapters"`
+}
+
+// SimpleChapter contains basic data about a chapter of an audiobook.
+type SimpleChapter struct {
+	// A URL to a 30 second preview (MP3 format) of the chapter.
+	AudioPreviewURL string `json:"audio_preview_url"`
+
+	// A list of the countries in which the chapter can be played,
+	// identified by their [ISO 3166-1 alpha-2] code.
+	//
+	// [ISO 3166-1 alpha-2]: http://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+	AvailableMarkets []string `json:"available_markets"`
+
+	// The number of the chapter within its audiobook, starting at 0.
+	ChapterNumber Numeric `json:"chapter_number"`
+
+	// A description of the chapter.  HTML tags are stripped away.
+	Description string `json:"description"`
+
+	// A description of the chapter which may contain HTML tags.
+	HTMLDescription string `json:"html_description"`
+
+	// The chapter length in milliseconds.
+	Duration Numeric `json:"duration_ms"`
+
+	// Whether or not the chapter has explicit content
+	// (true = yes it does; false = no it does not OR unknown).
+	Explicit bool `json:"explicit"`
+
+	// External URLs for this chapter.
+	ExternalURLs map[string]string `json:"external_urls"`
+
+	// A link to the Web API endpoint providing full details of the chapter.
+	Href string `json:"href"`
+
+	// The [Spotify ID] for the chapter.
+	//
+	// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+	ID ID `json:"id"`
+
+	// The cover art for the chapter in various sizes, widest first.
+	Images []Image `json:"images"`
+
+	// True if the chapter is playable in the given market.
+	// Otherwise false.
+	IsPlayable bool `json:"is_playable"`
+
+	// A list of the languages used in the chapter, identified by their [ISO 639] code.
+	//
+	// [ISO 639]: https://en.wikipedia.org/wiki/ISO_639
+	Languages []string `json:"languages"`
+
+	// The name of the chapter.
+	Name string `json:"name"`
+
+	// The date the chapter was first released, for example
+	// "1981-12-15". Depending on the precision, it might
+	// be shown as "1981" or "1981-12".
+	ReleaseDate string `json:"release_date"`
+
+	// The precision with which release_date value is known:
+	// "year", "month", or "day".
+	ReleaseDatePrecision string `json:"release_date_precision"`
+
+	// The user’s most recent position in the chapter. Set if the
+	// supplied access token is a user token and has the scope
+	// user-read-playback-position.
+	ResumePoint ResumePointObject `json:"resume_point"`
+
+	// The object type: "chapter".
+	Type string `json:"type"`
+
+	// The Spotify URI for the chapter.
+	URI URI `json:"uri"`
+}
+
+// Chapter contains full data about a chapter of an audiobook.
+type Chapter struct {
+	SimpleChapter
+
+	// The audiobook that the chapter belongs to.
+	Audiobook SimpleAudiobook `json:"audiobook"`
+}
+
+// ReleaseDateTime converts [SimpleChapter.ReleaseDate] to a [time.Time].
+// All of the fields in the result may not be valid.  For example, if
+// [SimpleChapter.ReleaseDatePrecision] is "month", then only the month and year
+// (but not the day) of the result are valid.
+func (c *SimpleChapter) ReleaseDateTime() time.Time {
+	if c.ReleaseDatePrecision == "day" {
+		result, _ := time.Parse(DateLayout, c.ReleaseDate)
+		return result
+	}
+	if c.ReleaseDatePrecision == "month" {
+		ym := strings.Split(c.ReleaseDate, "-")
+		year, _ := strconv.Atoi(ym[0])
+		month, _ := strconv.Atoi(ym[1])
+		return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	}
+	year, _ := strconv.Atoi(c.ReleaseDate)
+	return time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetAudiobook gets Spotify catalog information for a [single audiobook]
+// identified by its unique [Spotify ID].  Audiobooks are only available in
+// some markets.
+//
+// Supported options: [Market].
+//
+// [single audiobook]: https://developer.spotify.com/documentation/web-api/reference/get-an-audiobook
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetAudiobook(ctx context.Context, id ID, opts ...RequestOption) (*FullAudiobook, error) {
+	ctx = c.withOperation(ctx, "GetAudiobook")
+	spotifyURL := c.baseURL + "audiobooks/" + string(id)
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result FullAudiobook
+
+	err := c.getItem(ctx, spotifyURL, audiobookBatch, id, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetAudiobooks gets Spotify catalog information for [multiple audiobooks],
+// given their [Spotify ID]s.  Any number of IDs may be given; they are
+// requested 50 at a time, concurrently.  Audiobooks are returned in the order
+// requested.  If an audiobook is not found, that position in the result slice
+// will be nil.
+//
+// Supported options: [Market].
+//
+// [multiple audiobooks]: https://developer.spotify.com/documentation/web-api/reference/get-multiple-audiobooks
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetAudiobooks(ctx context.Context, ids []ID, opts ...RequestOption) ([]*FullAudiobook, error) {
+	ctx = c.withOperation(ctx, "GetAudiobooks")
+	return getSeveral[FullAudiobook](ctx, c, audiobookBatch, ids, opts...)
+}
+
+// GetAudiobookChapters gets the [chapters of an audiobook].  Further pages can
+// be fetched with [Client.NextPage].
+//
+// Supported options: [Market], [Limit], [Offset].
+//
+// [chapters of an audiobook]: https://developer.spotify.com/documentation/web-api/reference/get-audiobook-chapters
+func (c *Client) GetAudiobookChapters(ctx context.Context, id ID, opts ...RequestOption) (*SimpleChapterPage, error) {
+	ctx = c.withOperation(ctx, "GetAudiobookChapters")
+	spotifyURL := c.baseURL + "audiobooks/" + string(id) + "/chapters"
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result SimpleChapterPage
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetChapter gets Spotify catalog information for a [single chapter] of an
+// audiobook.
+//
+// Supported options: [Market].
+//
+// [single chapter]: https://developer.spotify.com/documentation/web-api/reference/get-a-chapter
+func (c *Client) GetChapter(ctx context.Context, id ID, opts ...RequestOption) (*Chapter, error) {
+	ctx = c.withOperation(ctx, "GetChapter")
+	spotifyURL := c.baseURL + "chapters/" + string(id)
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result Chapter
+
+	err := c.getItem(ctx, spotifyURL, chapterBatch, id, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetChapters gets Spotify catalog information for [several chapters], given
+// their [Spotify ID]s.  Any number of IDs may be given; they are requested 50
+// at a time, concurrently.  Chapters are returned in the order requested.  If a
+// chapter is not found, that position in the result slice will be nil.
+//
+// Supported options: [Market].
+//
+// [several chapters]: https://developer.spotify.com/documentation/web-api/reference/get-several-chapters
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetChapters(ctx context.Context, ids []ID, opts ...RequestOption) ([]*Chapter, error) {
+	ctx = c.withOperation(ctx, "GetChapters")
+	return getSeveral[Chapter](ctx, c, chapterBatch, ids, opts...)
+}
@@ -0,0 +1,172 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetAudiobook(t *testing.T) {
+	c, s := testClientFile(http.StatusOK, "test_data/get_audiobook.txt", func(r *http.Request) {
+		if r.URL.Path != "/audiobooks/7iHfbu1YPACw6oZPAFJtqe" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	defer s.Close()
+
+	a, err := c.GetAudiobook(context.Background(), "7iHfbu1YPACw6oZPAFJtqe", Market("US"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Name != "Dune: Book One in the Dune Chronicles" {
+		t.Error("Invalid name:", a.Name)
+	}
+	if len(a.Authors) != 1 || a.Authors[0].Name != "Frank Herbert" {
+		t.Error("Invalid authors:", a.Authors)
+	}
+	if len(a.Narrators) != 2 || a.Narrators[1].Name != "Orlagh Cassidy" {
+		t.Error("Invalid narrators:", a.Narrators)
+	}
+	if a.TotalChapters != 51 || a.Chapters.Total != 51 {
+		t.Error("Invalid chapter count:", a.TotalChapters, a.Chapters.Total)
+	}
+	if len(a.Chapters.Chapters) != 2 || a.Chapters.Chapters[1].Name != "Book One: Dune" {
+		t.Fatal("Invalid chapters:", a.Chapters.Chapters)
+	}
+	if a.Chapters.Chapters[1].Duration != 2181924 {
+		t.Error("Invalid duration:", a.Chapters.Chapters[1].Duration)
+	}
+}
+
+func TestGetAudiobooks(t *testing.T) {
+	client, server, requests := batchTestClient()
+	defer server.Close()
+
+	ids := make([]ID, 55)
+	for i := range ids {
+		ids[i] = ID(fmt.Sprintf("book%d", i))
+	}
+	ids[7] = "missing"
+
+	books, err := client.GetAudiobooks(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(books) != len(ids) {
+		t.Fatalf("Expected %d audiobooks, got %d", len(ids), len(books))
+	}
+	if books[7] != nil {
+		t.Error("Expected missing audiobook to be nil")
+	}
+	if books[54] == nil || books[54].ID != "book54" {
+		t.Errorf("Unexpected last audiobook %v", books[54])
+	}
+	if got := len(requests()); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+func TestGetAudiobookChapters(t *testing.T) {
+	json := `{
+		"href": "https://api.spotify.com/v1/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters?offset=1&limit=1",
+		"limit": 1,
+		"next": "https://api.spotify.com/v1/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters?offset=2&limit=1",
+		"offset": 1,
+		"previous": null,
+		"total": 51,
+		"items": [{"id": "2TbfJnrHSsbTCzyXaGL3bK", "name": "Book One: Dune", "chapter_number": 1, "type": "chapter"}]
+	}`
+	c, s := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if r.URL.Path != "/audiobooks/7iHfbu1YPACw6oZPAFJtqe/chapters" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("offset"); got != "1" {
+			t.Errorf("Expected offset 1, got %s", got)
+		}
+	})
+	defer s.Close()
+
+	page, err := c.GetAudiobookChapters(context.Background(), "7iHfbu1YPACw6oZPAFJtqe", Limit(1), Offset(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 51 || len(page.Chapters) != 1 {
+		t.Fatal("Invalid page:", page.Total, len(page.Chapters))
+	}
+	if page.Chapters[0].ChapterNumber != 1 {
+		t.Error("Invalid chapter number:", page.Chapters[0].ChapterNumber)
+	}
+}
+
+func TestGetChapter(t *testing.T) {
+	c, s := testClientFile(http.StatusOK, "test_data/get_chapter.txt", func(r *http.Request) {
+		if r.URL.Path != "/chapters/2TbfJnrHSsbTCzyXaGL3bK" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	})
+	defer s.Close()
+
+	ch, err := c.GetChapter(context.Background(), "2TbfJnrHSsbTCzyXaGL3bK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch.Type != "chapter" || ch.Name != "Book One: Dune" {
+		t.Error("Invalid chapter:", ch.Type, ch.Name)
+	}
+	if ch.Audiobook.ID != "7iHfbu1YPACw6oZPAFJtqe" {
+		t.Error("Invalid audiobook:", ch.Audiobook.ID)
+	}
+	if ch.ResumePoint.ResumePositionMs != 360000 {
+		t.Error("Invalid resume point:", ch.ResumePoint.ResumePositionMs)
+	}
+	if want := time.Date(2007, time.January, 1, 0, 0, 0, 0, time.UTC); !ch.ReleaseDateTime().Equal(want) {
+		t.Error("Invalid release date:", ch.ReleaseDateTime())
+	}
+}
+
+func TestGetChapterBatched(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(20*time.Millisecond))
+
+	done := make(chan error, 2)
+	for _, id := range []ID{"c1", "c2"} {
+		go func() {
+			_, err := client.GetChapter(context.Background(), id)
+			done <- err
+		}()
+	}
+	for range 2 {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+	reqs := requests()
+	if len(reqs) != 1 || !strings.HasPrefix(reqs[0], "/chapters?") {
+		t.Errorf("Expected a single request to /chapters, got %v", reqs)
+	}
+}
+
+func TestSearchAudiobooks(t *testing.T) {
+	json := `{"audiobooks": {"total": 1, "items": [{"id": "7iHfbu1YPACw6oZPAFJtqe", "name": "Dune", "type": "audiobook"}]}}`
+	c, s := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "track,audiobook" {
+			t.Errorf("Expected type track,audiobook, got %s", got)
+		}
+	})
+	defer s.Close()
+
+	res, err := c.Search(context.Background(), "dune", SearchTypeTrack|SearchTypeAudiobook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Audiobooks == nil || len(res.Audiobooks.Audiobooks) != 1 {
+		t.Fatal("Expected one audiobook result")
+	}
+	if res.Audiobooks.Audiobooks[0].Name != "Dune" {
+		t.Error("Invalid audiobook:", res.Audiobooks.Audiobooks[0].Name)
+	}
+}
@@ -65,6 +65,9 @@ const (
 	ScopeUserModifyPlaybackState = "user-modify-playback-state"
 	// ScopeUserReadRecentlyPlayed allows access to a user's recently-played songs
 	ScopeUserReadRecentlyPlayed = "user-read-recently-played"
+	// ScopeUserReadPlaybackPosition seeks read access to a user's playback
+	// position in episodes and chapters
+	ScopeUserReadPlaybackPosition = "user-read-playback-position"
 	// ScopeUserTopRead seeks read access to a user's top tracks and artists
 	ScopeUserTopRead = "user-top-read"
 	// ScopeStreaming seeks permission to play music and control playback on your other devices.
@@ -0,0 +1,327 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithBatching configures the Spotify API client to merge lookups of single
+// items into requests to the corresponding multi-item endpoints.
+//
+// Calls to [Client.GetTrack], [Client.GetArtist], [Client.GetAlbum],
+// [Client.GetAudiobook], [Client.GetChapter], [Client.GetEpisode] and
+// [Client.GetAudioFeatures] with a single ID that are made within window of
+// each other are sent as one request, up to the number of IDs the endpoint
+// accepts.  Each caller receives its own result; an ID that doesn't exist
+// results in an [Error] matching [ErrNotFound].  Lookups with options other
+// than [Market] are sent individually.
+//
+// In addition, concurrent GET requests for the same URL share a single
+// request and response.
+func WithBatching(window time.Duration) ClientOption {
+	return func(client *Client) {
+		client.batcher = &batcher{
+			window:  window,
+			pending: map[string]*batch{},
+			flights: map[string]*flight{},
+		}
+	}
+}
+
+// batchEndpoint describes a multi-item endpoint that lookups of single
+// items are merged into.
+type batchEndpoint struct {
+	// path of the endpoint relative to the base URL.
+	path string
+	// field of the response that holds the items.
+	field string
+	// operation reported to middleware for the merged request.
+	operation string
+	// limit is the maximum number of IDs per request.
+	limit int
+}
+
+var (
+	trackBatch         = batchEndpoint{path: "tracks", field: "tracks", operation: "GetTrack", limit: 50}
+	artistBatch        = batchEndpoint{path: "artists", field: "artists", operation: "GetArtist", limit: 50}
+	albumBatch         = batchEndpoint{path: "albums", field: "albums", operation: "GetAlbum", limit: 20}
+	audioFeaturesBatch = batchEndpoint{path: "audio-features", field: "audio_features", operation: "GetAudioFeatures", limit: 100}
+	audiobookBatch     = batchEndpoint{path: "audiobooks", field: "audiobooks", operation: "GetAudiobook", limit: 50}
+	chapterBatch       = batchEndpoint{path: "chapters", field: "chapters", operation: "GetChapter", limit: 50}
+	episodeBatch       = batchEndpoint{path: "episodes", field: "episodes", operation: "GetEpisode", limit: 50}
+	showBatch          = batchEndpoint{path: "shows", field: "shows", operation: "GetShow", limit: 50}
+)
+
+// batcher merges single item lookups and identical GET requests.
+type batcher struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*batch
+	flights map[string]*flight
+}
+
+// sharedCall is a request made on behalf of several callers.  The request
+// isn't cancelled when one of the callers gives up, only when all of them
+// have.
+type sharedCall struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu      sync.Mutex
+	waiters int
+}
+
+func newSharedCall(ctx context.Context) *sharedCall {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	return &sharedCall{ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+// join registers a caller that will wait for the call.
+func (s *sharedCall) join() {
+	s.mu.Lock()
+	s.waiters++
+	s.mu.Unlock()
+}
+
+// wait blocks until the call has finished or ctx is done.
+func (s *sharedCall) wait(ctx context.Context) error {
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		s.waiters--
+		abandoned := s.waiters == 0
+		s.mu.Unlock()
+		if abandoned {
+			s.cancel()
+		}
+		return ctx.Err()
+	}
+}
+
+// finish releases the callers waiting for the call.
+func (s *sharedCall) finish() {
+	close(s.done)
+	s.cancel()
+}
+
+// flight is a GET request shared by callers requesting the same URL.
+type flight struct {
+	*sharedCall
+	body []byte
+	err  error
+}
+
+// share calls fetch once for all concurrent callers with the same key and
+// returns its result to each of them.
+func (b *batcher) share(ctx context.Context, key string, fetch func(context.Context) ([]byte, error)) ([]byte, error) {
+	b.mu.Lock()
+	f := b.flights[key]
+	if f == nil || f.ctx.Err() != nil {
+		f = &flight{sharedCall: newSharedCall(ctx)}
+		b.flights[key] = f
+		go func() {
+			f.body, f.err = fetch(f.ctx)
+			b.mu.Lock()
+			if b.flights[key] == f {
+				delete(b.flights, key)
+			}
+			b.mu.Unlock()
+			f.finish()
+		}()
+	}
+	f.join()
+	b.mu.Unlock()
+
+	if err := f.wait(ctx); err != nil {
+		return nil, err
+	}
+	return f.body, f.err
+}
+
+// batch is a pending request to a multi-item endpoint.
+type batch struct {
+	*sharedCall
+	endpoint batchEndpoint
+	query    url.Values
+	ids      []ID
+	index    map[ID]int
+
+	results []json.RawMessage
+	err     error
+}
+
+// batchable reports whether a lookup with the options o can be merged into a
+// batch.
+func batchable(o requestOptions) bool {
+	if o.cacheMode != cacheDefault {
+		return false
+	}
+	for key := range o.urlParams {
+		if key != "market" {
+			return false
+		}
+	}
+	return true
+}
+
+// getItem gets the item with the given ID from spotifyURL and decodes it into
+// result, merging the lookup into a request to ep if batching is enabled.
+func (c *Client) getItem(ctx context.Context, spotifyURL string, ep batchEndpoint, id ID, result interface{}, opts ...RequestOption) error {
+	o := processOptions(opts...)
+	// Spotify rejects the whole request if one of its IDs is malformed, so
+	// such IDs are looked up on their own.
+	if c.batcher == nil || !batchable(o) || id == "" || !isBase62(string(id)) {
+		return c.get(ctx, spotifyURL, result, opts...)
+	}
+	raw, err := c.load(ctx, ep, id, o.urlParams)
+	if err != nil {
+		return err
+	}
+	if string(raw) == "null" {
+		return Error{
+			Status:   http.StatusNotFound,
+			Message:  "non existing id",
+			Method:   http.MethodGet,
+			Endpoint: "/" + ep.path + "/" + string(id),
+		}
+	}
+	return json.Unmarshal(raw, result)
+}
+
+// load adds id to the pending batch for ep and query and returns its item,
+// which is null if it doesn't exist.
+func (c *Client) load(ctx context.Context, ep batchEndpoint, id ID, query url.Values) (json.RawMessage, error) {
+	b := c.batcher
+	key := ep.path + "?" + query.Encode()
+
+	b.mu.Lock()
+	bt := b.pending[key]
+	if bt == nil || bt.ctx.Err() != nil {
+		bt = &batch{
+			sharedCall: newSharedCall(c.withOperation(ctx, ep.operation)),
+			endpoint:   ep,
+			query:      query,
+			index:      map[ID]int{},
+		}
+		b.pending[key] = bt
+		time.AfterFunc(b.window, func() { c.flush(key, bt) })
+	}
+	i, ok := bt.index[id]
+	if !ok {
+		i = len(bt.ids)
+		bt.index[id] = i
+		bt.ids = append(bt.ids, id)
+	}
+	bt.join()
+	if len(bt.ids) >= ep.limit {
+		delete(b.pending, key)
+		go c.sendBatch(bt)
+	}
+	b.mu.Unlock()
+
+	if err := bt.wait(ctx); err != nil {
+		return nil, err
+	}
+	if bt.err != nil {
+		return nil, bt.err
+	}
+	if i >= len(bt.results) {
+		return json.RawMessage("null"), nil
+	}
+	return bt.results[i], nil
+}
+
+// flush sends the request for bt, unless it has already been sent.
+func (c *Client) flush(key string, bt *batch) {
+	b := c.batcher
+	b.mu.Lock()
+	if b.pending[key] != bt {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.mu.Unlock()
+	c.sendBatch(bt)
+}
+
+// sendBatch sends the request for bt and releases its callers.
+func (c *Client) sendBatch(bt *batch) {
+	query := url.Values{}
+	for k, v := range bt.query {
+		query[k] = v
+	}
+	query.Set("ids", strings.Join(toStringSlice(bt.ids), ","))
+	spotifyURL := fmt.Sprintf("%s%s?%s", c.baseURL, bt.endpoint.path, query.Encode())
+
+	var result map[string][]json.RawMessage
+	bt.err = c.get(bt.ctx, spotifyURL, &result)
+	bt.results = result[bt.endpoint.field]
+	bt.finish()
+}
+
+// getSeveral gets the items with the given IDs from the multi-item endpoint
+// ep.  The IDs are split into requests of at most ep.limit IDs, which are
+// sent concurrently.  The result is aligned with ids; items that don't exist
+// are nil.
+func getSeveral[T any](ctx context.Context, c *Client, ep batchEndpoint, ids []ID, opts ...RequestOption) ([]*T, error) {
+	result := make([]*T, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, defaultConcurrency)
+	)
+	for start := 0; start < len(ids); start += ep.limit {
+		chunk := ids[start:min(start+ep.limit, len(ids))]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			params := processOptions(opts...).urlParams
+			params.Set("ids", strings.Join(toStringSlice(chunk), ","))
+			spotifyURL := fmt.Sprintf("%s%s?%s", c.baseURL, ep.path, params.Encode())
+
+			var items map[string][]*T
+			if err := c.get(ctx, spotifyURL, &items, opts...); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			copy(result[start:start+len(chunk)], items[ep.field])
+		}()
+	}
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
@@ -0,0 +1,245 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// batchTestClient serves multi-item endpoints, returning null for the ID
+// "missing", and records the path and ids parameter of every request.  Like
+// Spotify, it rejects requests with an ID that isn't base-62.
+func batchTestClient() (*Client, *httptest.Server, func() []string) {
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		ids := r.URL.Query().Get("ids")
+		field := strings.ReplaceAll(strings.TrimPrefix(r.URL.Path, "/"), "-", "_")
+		_, single, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if !isBase62(strings.ReplaceAll(ids, ",", "") + single) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": {"status": 400, "message": "invalid id"}}`)
+			return r.URL.Path + "?" + ids
+		}
+		var items []string
+		for _, id := range strings.Split(ids, ",") {
+			if id == "missing" {
+				items = append(items, "null")
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"id": %q, "name": "name of %s"}`, id, id))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{%q: [%s]}`, field, strings.Join(items, ","))
+		return r.URL.Path + "?" + ids
+	})
+}
+
+func TestBatchingMergesLookups(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(50*time.Millisecond))
+
+	ids := []ID{"a", "b", "a", "missing"}
+	tracks := make([]*FullTrack, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tracks[i], errs[i] = client.GetTrack(context.Background(), id)
+		}()
+	}
+	wg.Wait()
+
+	for i, id := range ids[:3] {
+		if errs[i] != nil {
+			t.Fatalf("Unexpected error for %s: %v", id, errs[i])
+		}
+		if tracks[i].ID != id {
+			t.Errorf("Expected track %s, got %s", id, tracks[i].ID)
+		}
+	}
+	if !errors.Is(errs[3], ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for missing track, got %v", errs[3])
+	}
+
+	got := requests()
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 request, got %v", got)
+	}
+	_, query, _ := strings.Cut(got[0], "?")
+	if sent := strings.Split(query, ","); len(sent) != 3 {
+		t.Errorf("Expected 3 distinct IDs to be requested, got %v", sent)
+	}
+}
+
+func TestBatchingMalformedID(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(50*time.Millisecond))
+
+	ids := []ID{"a", "not-an-id", "b"}
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = client.GetTrack(context.Background(), id)
+		}()
+	}
+	wg.Wait()
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("Expected the valid IDs to be found, got %v and %v", errs[0], errs[2])
+	}
+	var serr Error
+	if !errors.As(errs[1], &serr) || serr.Status != http.StatusBadRequest {
+		t.Errorf("Expected HTTP 400 for the malformed ID, got %v", errs[1])
+	}
+	got := requests()
+	slices.Sort(got)
+	if len(got) != 2 || got[0] != "/tracks/not-an-id?" || (got[1] != "/tracks?a,b" && got[1] != "/tracks?b,a") {
+		t.Errorf("Expected one batch for a and b and one request for the malformed ID, got %v", got)
+	}
+}
+
+func TestBatchingRespectsLimit(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(time.Second))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < albumBatch.limit*2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := ID(fmt.Sprintf("album%d", i))
+			album, err := client.GetAlbum(context.Background(), id)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if album.ID != id {
+				t.Errorf("Expected album %s, got %s", id, album.ID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Full batches are sent without waiting for the window to close.
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected full batches to be sent immediately, took %v", elapsed)
+	}
+	if got := requests(); len(got) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(got))
+	}
+}
+
+func TestBatchingAudioFeatures(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(50*time.Millisecond))
+
+	var wg sync.WaitGroup
+	for _, id := range []ID{"a", "missing"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			features, err := client.GetAudioFeatures(context.Background(), id)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(features) != 1 {
+				t.Errorf("Expected 1 result, got %d", len(features))
+				return
+			}
+			if id == "missing" && features[0] != nil {
+				t.Errorf("Expected nil features for missing track, got %+v", features[0])
+			}
+			if id != "missing" && features[0] == nil {
+				t.Errorf("Expected features for track %s", id)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := requests(); len(got) != 1 || !strings.HasPrefix(got[0], "/audio-features?") {
+		t.Errorf("Expected 1 audio features request, got %v", got)
+	}
+}
+
+func TestBatchingCancelledCaller(t *testing.T) {
+	_, server, requests := batchTestClient()
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(50*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := client.GetArtist(ctx, "a"); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	}()
+	artist, err := client.GetArtist(context.Background(), "b")
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if artist.ID != "b" {
+		t.Errorf("Expected artist b, got %s", artist.ID)
+	}
+	if got := requests(); len(got) != 1 {
+		t.Errorf("Expected 1 request, got %v", got)
+	}
+}
+
+func TestBatchingSharesIdenticalRequests(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"name": "Shared"}`))
+	}))
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithBatching(time.Millisecond))
+
+	const callers = 5
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			playlist, err := client.GetPlaylist(context.Background(), "playlist")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if playlist.Name != "Shared" {
+				t.Errorf("Expected Shared, got %s", playlist.Name)
+			}
+		}()
+	}
+	// Give every caller time to join the request before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
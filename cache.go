@@ -0,0 +1,338 @@
+package spotify
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheEntry is a cached response to a GET request.
+type CacheEntry struct {
+	// Body is the JSON body of the response.
+	Body []byte `json:"body"`
+	// ETag is the entity tag of the response, sent in If-None-Match when
+	// the entry is revalidated.
+	ETag string `json:"etag,omitempty"`
+	// Expires is the time after which the entry must be revalidated before
+	// it is used.
+	Expires time.Time `json:"expires"`
+}
+
+// fresh reports whether the entry can be used without revalidation.
+func (e *CacheEntry) fresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+// Cache stores responses to GET requests, keyed by request URL.
+//
+// A Cache must be safe for concurrent use.  Don't share a Cache between
+// clients that are authenticated as different users, as responses from
+// endpoints such as "me/playlists" would leak between them.
+type Cache interface {
+	// Get returns the entry stored for key, if any.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores entry for key, replacing any existing entry.
+	Set(key string, entry *CacheEntry)
+	// Delete removes the entry stored for key, if any.
+	Delete(key string)
+}
+
+// WithCache configures the Spotify API client to cache responses to GET
+// requests in cache.  Responses are cached according to their Cache-Control
+// and ETag headers.  Fresh responses are served from the cache, and stale
+// responses with an ETag are revalidated with If-None-Match.
+//
+// Use [BypassCache] and [RevalidateCache] to control caching per request.
+func WithCache(cache Cache) ClientOption {
+	return func(client *Client) {
+		client.cache = cache
+	}
+}
+
+// cacheMode controls how a single request uses the client's cache.
+type cacheMode int
+
+const (
+	cacheDefault cacheMode = iota
+	cacheBypass
+	cacheRevalidate
+)
+
+// cacheKey returns the key under which the response to url is cached.
+func (c *Client) cacheKey(url string) string {
+	if c.acceptLanguage != "" {
+		return url + "|" + c.acceptLanguage
+	}
+	return url
+}
+
+// fetch sends a GET request to url and returns the body of the response,
+// or nil if the response has no content.  When batching is enabled,
+// concurrent requests for the same URL share a single response.
+func (c *Client) fetch(ctx context.Context, url string, o requestOptions) ([]byte, error) {
+	if c.batcher == nil {
+		return c.fetchOnce(ctx, url, o)
+	}
+	key := fmt.Sprintf("%d|%s", o.cacheMode, c.cacheKey(url))
+	return c.batcher.share(ctx, key, func(ctx context.Context) ([]byte, error) {
+		return c.fetchOnce(ctx, url, o)
+	})
+}
+
+// fetchOnce is like fetch, but always sends its own request.
+func (c *Client) fetchOnce(ctx context.Context, url string, o requestOptions) ([]byte, error) {
+	var (
+		key    string
+		cached *CacheEntry
+	)
+	useCache := c.cache != nil && o.cacheMode != cacheBypass
+	if useCache {
+		key = c.cacheKey(url)
+		if entry, ok := c.cache.Get(key); ok {
+			if o.cacheMode != cacheRevalidate && entry.fresh(time.Now()) {
+				return entry.Body, nil
+			}
+			cached = entry
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	resp, err := c.do(req, http.StatusOK, http.StatusNoContent, http.StatusNotModified)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		entry := &CacheEntry{
+			Body:    cached.Body,
+			ETag:    cached.ETag,
+			Expires: expiry(resp.Header, time.Now()),
+		}
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		c.cache.Set(key, entry)
+		return entry.Body, nil
+	case resp.StatusCode == http.StatusNoContent:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, decodeError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if useCache {
+		if entry, ok := newCacheEntry(resp.Header, body, time.Now()); ok {
+			c.cache.Set(key, entry)
+		} else if cached != nil {
+			c.cache.Delete(key)
+		}
+	}
+	return body, nil
+}
+
+// newCacheEntry returns an entry for a response with the given headers and
+// body, or false if the response must not be cached.
+func newCacheEntry(header http.Header, body []byte, now time.Time) (*CacheEntry, bool) {
+	cc := header.Get("Cache-Control")
+	if hasDirective(cc, "no-store") {
+		return nil, false
+	}
+	entry := &CacheEntry{
+		Body:    body,
+		ETag:    header.Get("ETag"),
+		Expires: expiry(header, now),
+	}
+	// Without an ETag, a response is only useful while it is fresh.
+	if entry.ETag == "" && !entry.fresh(now) {
+		return nil, false
+	}
+	return entry, true
+}
+
+// expiry returns the time until which a response with the given headers is
+// fresh, based on the max-age directive of its Cache-Control header.
+func expiry(header http.Header, now time.Time) time.Time {
+	cc := header.Get("Cache-Control")
+	if hasDirective(cc, "no-cache") {
+		return now
+	}
+	for _, directive := range strings.Split(cc, ",") {
+		value, ok := strings.CutPrefix(strings.TrimSpace(directive), "max-age=")
+		if !ok {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return now.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+	return now
+}
+
+// hasDirective reports whether the Cache-Control header value cc contains
+// the given directive.
+func hasDirective(cc, directive string) bool {
+	for _, d := range strings.Split(cc, ",") {
+		if strings.EqualFold(strings.TrimSpace(d), directive) {
+			return true
+		}
+	}
+	return false
+}
+
+// MemoryCache is an in-memory [Cache] that evicts the least recently used
+// entries once it is full.
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns a [MemoryCache] that holds up to maxEntries
+// responses.  A maxEntries of zero or less means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+}
+
+// Get returns the entry stored for key, if any.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.lru.MoveToFront(el)
+	return el.Value.(*memoryCacheItem).entry, true
+}
+
+// Set stores entry for key, evicting the least recently used entry if the
+// cache is full.
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryCacheItem).entry = entry
+		m.lru.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.lru.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete removes the entry stored for key, if any.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		m.lru.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// DiskCache is a [Cache] that stores each response as a file in a
+// directory, so that cached responses survive restarts.  Failures to read
+// or write the directory are treated as cache misses.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a [DiskCache] that stores responses in dir, creating
+// the directory if it doesn't exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// path returns the file in which the entry for key is stored.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the entry stored for key, if any.
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// Set stores entry for key, replacing any existing entry.
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	// Write to a temporary file first so that readers never see a
+	// partially written entry.
+	f, err := os.CreateTemp(d.dir, "entry-*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
+
+// Delete removes the entry stored for key, if any.
+func (d *DiskCache) Delete(key string) {
+	_ = os.Remove(d.path(key))
+}
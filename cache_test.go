@@ -0,0 +1,156 @@
+package spotify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// cacheTestClient returns a client with a memory cache, backed by a server
+// that serves a track with the given Cache-Control header and an ETag, and
+// answers matching If-None-Match requests with 304 Not Modified.
+func cacheTestClient(cacheControl string) (*Client, *httptest.Server, *int32, *int32) {
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if cacheControl != "" {
+			w.Header().Set("Cache-Control", cacheControl)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Timber"}`))
+	}))
+	client := &Client{
+		http:    http.DefaultClient,
+		baseURL: server.URL + "/",
+		cache:   NewMemoryCache(10),
+	}
+	return client, server, &requests, &notModified
+}
+
+func TestCacheServesFreshResponses(t *testing.T) {
+	client, server, requests, _ := cacheTestClient("max-age=60")
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		track, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if track.Name != "Timber" {
+			t.Errorf("Expected Timber, got %s", track.Name)
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestCacheRevalidatesStaleResponses(t *testing.T) {
+	client, server, requests, notModified := cacheTestClient("no-cache")
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		track, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if track.Name != "Timber" {
+			t.Errorf("Expected Timber, got %s", track.Name)
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+	if got := atomic.LoadInt32(notModified); got != 1 {
+		t.Errorf("Expected 1 revalidated response, got %d", got)
+	}
+}
+
+func TestCacheRequestOptions(t *testing.T) {
+	client, server, requests, notModified := cacheTestClient("max-age=60")
+	defer server.Close()
+
+	ctx := context.Background()
+	if _, err := client.GetTrack(ctx, "1zHlj4dQ8ZAtrayhuDDmkY"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetTrack(ctx, "1zHlj4dQ8ZAtrayhuDDmkY", RevalidateCache()); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(notModified); got != 1 {
+		t.Errorf("Expected RevalidateCache to send If-None-Match, got %d revalidations", got)
+	}
+	if _, err := client.GetTrack(ctx, "1zHlj4dQ8ZAtrayhuDDmkY", BypassCache()); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+	if got := atomic.LoadInt32(notModified); got != 1 {
+		t.Errorf("Expected BypassCache not to send If-None-Match, got %d revalidations", got)
+	}
+}
+
+func TestCacheNoStore(t *testing.T) {
+	client, server, requests, _ := cacheTestClient("no-store")
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := client.cache.(*MemoryCache).Len(); got != 0 {
+		t.Errorf("Expected empty cache, got %d entries", got)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CacheEntry{Body: []byte("a")})
+	cache.Set("b", &CacheEntry{Body: []byte("b")})
+	cache.Get("a")
+	cache.Set("c", &CacheEntry{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("Expected entry %s to be cached", key)
+		}
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expires := time.Now().Add(time.Minute).Truncate(time.Second)
+	cache.Set("key", &CacheEntry{Body: []byte(`{"name": "Timber"}`), ETag: `"v1"`, Expires: expires})
+
+	entry, ok := cache.Get("key")
+	if !ok {
+		t.Fatal("Expected entry to be cached")
+	}
+	if string(entry.Body) != `{"name": "Timber"}` || entry.ETag != `"v1"` || !entry.Expires.Equal(expires) {
+		t.Errorf("Unexpected entry %+v", entry)
+	}
+
+	cache.Delete("key")
+	if _, ok := cache.Get("key"); ok {
+		t.Error("Expected entry to be deleted")
+	}
+}
@@ -0,0 +1,92 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+)
+
+// Category is used by Spotify to tag items in.  For example, on the Spotify
+// player's "Browse" tab.
+type Category struct {
+	// A link to the Web API endpoint returning full details of the category.
+	Endpoint string `json:"href"`
+	// The category icon, in various sizes.
+	Icons []Image `json:"icons"`
+	// The Spotify category ID.  This isn't a base-62 Spotify ID, it's just
+	// a short string that describes and identifies the category (ie "party").
+	ID string `json:"id"`
+	// The name of the category.
+	Name string `json:"name"`
+}
+
+// GetCategories gets a [list of categories] used to tag items in Spotify.
+// Further pages can be fetched with [Client.NextPage].
+//
+// Supported options: [Country], [Locale], [Limit], [Offset].
+//
+// [list of categories]: https://developer.spotify.com/documentation/web-api/reference/get-categories
+func (c *Client) GetCategories(ctx context.Context, opts ...RequestOption) (*CategoryPage, error) {
+	ctx = c.withOperation(ctx, "GetCategories")
+	spotifyURL := c.baseURL + "browse/categories"
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result struct {
+		Categories CategoryPage `json:"categories"`
+	}
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Categories, nil
+}
+
+// GetCategory gets a [single category] used to tag items in Spotify.
+//
+// Supported options: [Country], [Locale].
+//
+// [single category]: https://developer.spotify.com/documentation/web-api/reference/get-a-category
+func (c *Client) GetCategory(ctx context.Context, id string, opts ...RequestOption) (*Category, error) {
+	ctx = c.withOperation(ctx, "GetCategory")
+	spotifyURL := fmt.Sprintf("%sbrowse/categories/%s", c.baseURL, id)
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var category Category
+
+	err := c.get(ctx, spotifyURL, &category, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// GetCategoryPlaylists gets a [list of Spotify playlists] tagged with a
+// particular category.  Further pages can be fetched with [Client.NextPage].
+//
+// Supported options: [Country], [Limit], [Offset].
+//
+// [list of Spotify playlists]: https://developer.spotify.com/documentation/web-api/reference/get-a-categories-playlists
+func (c *Client) GetCategoryPlaylists(ctx context.Context, categoryID string, opts ...RequestOption) (*SimplePlaylistPage, error) {
+	ctx = c.withOperation(ctx, "GetCategoryPlaylists")
+	spotifyURL := fmt.Sprintf("%sbrowse/categories/%s/playlists", c.baseURL, categoryID)
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result struct {
+		Playlists SimplePlaylistPage `json:"playlists"`
+	}
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Playlists, nil
+}
@@ -0,0 +1,81 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetCategories(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("locale") != "sv_SE" {
+			t.Errorf("Expected locale sv_SE, got %s", r.URL)
+		}
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"categories": {"href": "%[1]s/browse/categories", "limit": 1, "offset": 0, "total": 2,
+				"next": "%[1]s/browse/categories?locale=sv_SE&offset=1&limit=1",
+				"items": [{"id": "toplists", "name": "Topplistor"}]}}`, server.URL)
+			return
+		}
+		fmt.Fprint(w, `{"categories": {"limit": 1, "offset": 1, "total": 2, "next": null,
+			"items": [{"id": "mood", "name": "Humör"}]}}`)
+	}))
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	page, err := client.GetCategories(context.Background(), Locale("sv_SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Categories) != 1 || page.Categories[0].ID != "toplists" || int(page.Total) != 2 {
+		t.Fatalf("Unexpected first page %+v", page)
+	}
+
+	// The next page is wrapped in a "categories" object as well.
+	if err := client.NextPage(context.Background(), page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Categories) != 1 || page.Categories[0].Name != "Humör" {
+		t.Errorf("Unexpected second page %+v", page)
+	}
+	if err := client.NextPage(context.Background(), page); err != ErrNoMorePages {
+		t.Errorf("Expected ErrNoMorePages, got %v", err)
+	}
+}
+
+func TestGetCategory(t *testing.T) {
+	client, server := testClientString(http.StatusOK, `{"href": "https://api.spotify.com/v1/browse/categories/dinner", "icons": [{"url": "https://t.scdn.co/media/original/dinner_1b6506abba0ba52c54e6d695c8571078_274x274.jpg"}], "id": "dinner", "name": "Dinner"}`, func(r *http.Request) {
+		if r.URL.Path != "/browse/categories/dinner" || r.URL.Query().Get("country") != "SE" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	category, err := client.GetCategory(context.Background(), "dinner", Country("SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if category.ID != "dinner" || category.Name != "Dinner" || len(category.Icons) != 1 {
+		t.Errorf("Unexpected category %+v", category)
+	}
+}
+
+func TestGetCategoryPlaylists(t *testing.T) {
+	client, server := testClientString(http.StatusOK, `{"playlists": {"limit": 2, "offset": 4, "total": 30, "items": [{"id": "one", "name": "Dinner with Friends"}, {"id": "two", "name": "Jazzy Dinner"}]}}`, func(r *http.Request) {
+		if r.URL.Path != "/browse/categories/dinner/playlists" || r.URL.Query().Get("limit") != "2" || r.URL.Query().Get("offset") != "4" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	playlists, err := client.GetCategoryPlaylists(context.Background(), "dinner", Limit(2), Offset(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(playlists.Playlists) != 2 || playlists.Playlists[1].Name != "Jazzy Dinner" || int(playlists.Total) != 30 {
+		t.Errorf("Unexpected playlists %+v", playlists)
+	}
+}
@@ -1,14 +1,21 @@
 package spotify
 
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
 // This file contains the types that implement Spotify's cursor-based
 // paging object.  Like the standard paging object, this object is a
 // container for a set of items. Unlike the standard paging object, a
 // cursor-based paging object does not provide random access to the results.
 
-// Cursor contains a key that can be used to find the next set
-// of items.
+// Cursor contains the keys that can be used to find the next
+// or previous set of items.
 type Cursor struct {
-	After string `json:"after"`
+	After  string `json:"after"`
+	Before string `json:"before"`
 }
 
 // cursorPage contains all of the fields in a Spotify cursor-based
@@ -35,3 +42,40 @@ type FullArtistCursorPage struct {
 	cursorPage
 	Artists []FullArtist `json:"items"`
 }
+
+func (p *cursorPage) cursor() *cursorPage { return p }
+
+func (p *cursorPage) nextURL() string { return p.Next }
+
+func (p *FullArtistCursorPage) items() []FullArtist { return p.Artists }
+
+// cursorPageable is an internal interface for types that support paging
+// by embedding cursorPage.
+type cursorPageable interface{ cursor() *cursorPage }
+
+// NextCursorPage fetches the next set of items of a cursor-based paging
+// object, such as a [FullArtistCursorPage] or a [RecentlyPlayedResult], and
+// writes them into p.  The page's Next URL already carries the cursor, so
+// callers don't need to pass [After] or a timestamp themselves.
+// It returns [ErrNoMorePages] if p already contains the last page.
+//
+// To iterate over all of the items, use [Items] instead.
+func (c *Client) NextCursorPage(ctx context.Context, p cursorPageable) error {
+	ctx = c.withOperation(ctx, "NextCursorPage")
+	if p == nil || reflect.ValueOf(p).IsNil() {
+		return fmt.Errorf("spotify: p must be a non-nil pointer to a page")
+	}
+
+	nextURL := p.cursor().Next
+	if len(nextURL) == 0 {
+		return ErrNoMorePages
+	}
+
+	// Zero out the page so that we can overwrite it in the next
+	// call to get. This is necessary because encoding/json does
+	// not clear out existing values when unmarshaling JSON null.
+	val := reflect.ValueOf(p).Elem()
+	val.Set(reflect.Zero(val.Type()))
+
+	return c.getPage(ctx, nextURL, p)
+}
@@ -0,0 +1,208 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrDeviceNotFound is the error returned when no device matches a
+// [DeviceSelector].
+var ErrDeviceNotFound = errors.New("spotify: device not found")
+
+// DeviceSelector describes the device to control by its name or type
+// rather than by its ID, which changes when some devices restart.  A device
+// must match every field that is set; a zero DeviceSelector matches any
+// device.
+type DeviceSelector struct {
+	// Name of the device, such as "Kitchen".  Names are compared without
+	// regard to case.
+	Name string
+	// Fuzzy also matches devices whose name contains Name, or is contained
+	// in it, ignoring punctuation and spacing.  "kitchen" matches
+	// "Kitchen Speaker".  Exact matches are preferred.
+	Fuzzy bool
+	// Type of the device, such as [DeviceTypeSpeaker].
+	Type DeviceType
+}
+
+func (s DeviceSelector) String() string {
+	var parts []string
+	if s.Name != "" {
+		parts = append(parts, fmt.Sprintf("name %q", s.Name))
+	}
+	if s.Type != "" {
+		parts = append(parts, fmt.Sprintf("type %s", s.Type))
+	}
+	if len(parts) == 0 {
+		return "any device"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DeviceOption is an option for [Client.ResolveDevice] and
+// [Client.PlayOnDevice].
+type DeviceOption func(*deviceOptions)
+
+type deviceOptions struct {
+	timeout      time.Duration
+	pollInterval time.Duration
+	transfer     bool
+}
+
+// WaitForDevice keeps looking for a matching device for up to timeout.
+// Devices such as speakers are often not listed until they wake up.
+func WaitForDevice(timeout time.Duration) DeviceOption {
+	return func(o *deviceOptions) {
+		o.timeout = timeout
+	}
+}
+
+// DevicePollInterval sets how often the list of devices is read while
+// waiting for a device.  The default, which is also used for intervals that
+// aren't positive, is every second.
+func DevicePollInterval(d time.Duration) DeviceOption {
+	return func(o *deviceOptions) {
+		o.pollInterval = d
+	}
+}
+
+// TransferToDevice makes [Client.PlayOnDevice] transfer playback to the
+// device before starting playback on it, which wakes up devices that ignore
+// play commands while inactive.
+func TransferToDevice() DeviceOption {
+	return func(o *deviceOptions) {
+		o.transfer = true
+	}
+}
+
+// ResolveDevice finds the device of the current user that matches sel.
+// Restricted devices, which don't accept commands, and devices without an
+// ID are ignored.  If several devices match equally well, the active one is
+// preferred, then the first one listed.  It returns an error matching
+// [ErrDeviceNotFound] if no device matches, after waiting if
+// [WaitForDevice] is given.
+//
+// Requires the [ScopeUserReadPlaybackState] scope.
+func (c *Client) ResolveDevice(ctx context.Context, sel DeviceSelector, opts ...DeviceOption) (*PlayerDevice, error) {
+	ctx = c.withOperation(ctx, "ResolveDevice")
+	var o deviceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.pollInterval <= 0 {
+		o.pollInterval = time.Second
+	}
+
+	deadline := time.Now().Add(o.timeout)
+	for {
+		devices, err := c.playerDevices(ctx, BypassCache())
+		if err != nil {
+			return nil, err
+		}
+		if device := selectDevice(devices, sel); device != nil {
+			return device, nil
+		}
+
+		wait := min(o.pollInterval, time.Until(deadline))
+		if wait <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrDeviceNotFound, sel)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// PlayOnDevice finds the device that matches sel, as [Client.ResolveDevice]
+// does, and starts playback on it with the given options.  opt may be nil;
+// its DeviceID is ignored.  It returns the device that was used.
+//
+// Requires the [ScopeUserReadPlaybackState] and
+// [ScopeUserModifyPlaybackState] scopes.
+func (c *Client) PlayOnDevice(ctx context.Context, sel DeviceSelector, opt *PlayOptions, opts ...DeviceOption) (*PlayerDevice, error) {
+	ctx = c.withOperation(ctx, "PlayOnDevice")
+	var o deviceOptions
+	for _, f := range opts {
+		f(&o)
+	}
+
+	device, err := c.ResolveDevice(ctx, sel, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.transfer && !device.Active {
+		if err := c.TransferPlayback(ctx, device.ID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	var play PlayOptions
+	if opt != nil {
+		play = *opt
+	}
+	play.DeviceID = &device.ID
+	if err := c.PlayOpt(ctx, &play); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// Ranks of how well a device name matches a selector.
+const (
+	noMatch = iota
+	fuzzyMatch
+	exactMatch
+)
+
+// selectDevice returns the device that best matches sel, or nil.
+func selectDevice(devices []PlayerDevice, sel DeviceSelector) *PlayerDevice {
+	var (
+		best     *PlayerDevice
+		bestRank = noMatch
+	)
+	for i := range devices {
+		d := &devices[i]
+		if d.Restricted || d.ID == "" || (sel.Type != "" && d.Type != sel.Type) {
+			continue
+		}
+		rank := matchDeviceName(d.Name, sel)
+		if rank > bestRank || (rank == bestRank && rank != noMatch && d.Active && !best.Active) {
+			best, bestRank = d, rank
+		}
+	}
+	return best
+}
+
+func matchDeviceName(name string, sel DeviceSelector) int {
+	switch {
+	case sel.Name == "" || strings.EqualFold(name, sel.Name):
+		return exactMatch
+	case !sel.Fuzzy:
+		return noMatch
+	}
+	n, want := normalizeDeviceName(name), normalizeDeviceName(sel.Name)
+	if want != "" && n != "" && (strings.Contains(n, want) || strings.Contains(want, n)) {
+		return fuzzyMatch
+	}
+	return noMatch
+}
+
+// normalizeDeviceName lower cases name and removes everything but letters
+// and digits.
+func normalizeDeviceName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
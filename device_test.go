@@ -0,0 +1,146 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testDevices = `{"devices": [
+	{"id": "laptop", "name": "YOUR-LAPTOP", "type": "Computer", "is_active": true},
+	{"id": "tv", "name": "Living Room TV", "type": "TV", "is_restricted": true},
+	{"id": "kitchen-display", "name": "Kitchen Display", "type": "Speaker"},
+	{"id": "kitchen", "name": "Kitchen", "type": "Speaker"},
+	{"id": "bedroom", "name": "Bedroom Speaker", "type": "Speaker"}
+]}`
+
+func TestSelectDevice(t *testing.T) {
+	var result struct {
+		Devices []PlayerDevice `json:"devices"`
+	}
+	if err := json.Unmarshal([]byte(testDevices), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		sel  DeviceSelector
+		want ID
+	}{
+		{DeviceSelector{}, "laptop"},
+		{DeviceSelector{Name: "kitchen"}, "kitchen"},
+		{DeviceSelector{Name: "Kitchen", Fuzzy: true}, "kitchen"},
+		{DeviceSelector{Name: "bedroom"}, ""},
+		{DeviceSelector{Name: "bedroom", Fuzzy: true}, "bedroom"},
+		{DeviceSelector{Name: "your laptop", Fuzzy: true}, "laptop"},
+		{DeviceSelector{Name: "Living Room TV"}, ""},
+		{DeviceSelector{Type: DeviceTypeSpeaker}, "kitchen-display"},
+		{DeviceSelector{Name: "bedroom", Fuzzy: true, Type: DeviceTypeComputer}, ""},
+	}
+	for _, tt := range tests {
+		var got ID
+		if d := selectDevice(result.Devices, tt.sel); d != nil {
+			got = d.ID
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.sel, tt.want, got)
+		}
+	}
+}
+
+// deviceTestClient lists no devices for the first hidden requests and
+// testDevices afterwards.  It records the other requests it receives.
+func deviceTestClient(hidden int) (*Client, *httptest.Server, func() []string) {
+	listed := 0
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		if r.URL.Path == "/me/player/devices" {
+			listed++
+			if listed <= hidden {
+				_, _ = w.Write([]byte(`{"devices": []}`))
+				return ""
+			}
+			_, _ = w.Write([]byte(testDevices))
+			return ""
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+		return fmt.Sprintf("%s %s %s", r.Method, r.URL.RequestURI(), strings.TrimSpace(string(body)))
+	})
+}
+
+func TestResolveDeviceWaits(t *testing.T) {
+	client, server, _ := deviceTestClient(2)
+	defer server.Close()
+
+	_, err := client.ResolveDevice(context.Background(), DeviceSelector{Name: "Kitchen"})
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("Expected device not found without waiting, got %v", err)
+	}
+
+	device, err := client.ResolveDevice(context.Background(), DeviceSelector{Name: "Kitchen"},
+		WaitForDevice(time.Second), DevicePollInterval(5*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if device.ID != "kitchen" {
+		t.Errorf("Expected the kitchen device, got %s", device.ID)
+	}
+}
+
+func TestResolveDeviceZeroPollInterval(t *testing.T) {
+	client, server, _ := deviceTestClient(1)
+	defer server.Close()
+
+	// The default interval of a second is used instead.
+	device, err := client.ResolveDevice(context.Background(), DeviceSelector{Name: "Kitchen"},
+		WaitForDevice(5*time.Second), DevicePollInterval(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if device.ID != "kitchen" {
+		t.Errorf("Expected the kitchen device, got %s", device.ID)
+	}
+}
+
+func TestResolveDeviceTimeout(t *testing.T) {
+	client, server, _ := deviceTestClient(1000)
+	defer server.Close()
+
+	start := time.Now()
+	_, err := client.ResolveDevice(context.Background(), DeviceSelector{Name: "Kitchen"},
+		WaitForDevice(50*time.Millisecond), DevicePollInterval(10*time.Millisecond))
+	if !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("Expected device not found, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Errorf("Expected to wait about 50ms, waited %s", elapsed)
+	}
+}
+
+func TestPlayOnDevice(t *testing.T) {
+	client, server, requests := deviceTestClient(0)
+	defer server.Close()
+
+	uri := URI("spotify:album:4aawyAB9vmqN3uQ7FjRGTy")
+	device, err := client.PlayOnDevice(context.Background(), DeviceSelector{Name: "kitchen"},
+		&PlayOptions{PlaybackContext: &uri}, TransferToDevice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if device.ID != "kitchen" {
+		t.Errorf("Expected the kitchen device, got %s", device.ID)
+	}
+	want := []string{
+		`PUT /me/player {"device_ids":["kitchen"],"play":false}`,
+		`PUT /me/player/play?device_id=kitchen {"context_uri":"spotify:album:4aawyAB9vmqN3uQ7FjRGTy"}`,
+	}
+	if got := requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected requests\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
@@ -0,0 +1,138 @@
+package spotify
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// DiscographyAlbum is an album in an artist's discography, as returned by
+// [Client.GetArtistDiscography].
+type DiscographyAlbum struct {
+	// FullAlbum is the album.  Unlike the result of [Client.GetAlbum], its
+	// Tracks page contains every track of the album.
+	FullAlbum
+	// Editions contains the other editions of the album that were collapsed
+	// into it, such as remasters, deluxe editions and copies released for
+	// other markets.
+	Editions []SimpleAlbum
+}
+
+// GetArtistDiscography gets an artist's complete discography: every album
+// of the given album types, along with all of its tracks.  A nil ts includes
+// every type of album.
+//
+// Spotify often lists several editions of the same album, such as remasters,
+// deluxe editions or copies released for different markets.  These are
+// collapsed into a single [DiscographyAlbum], which is the earliest release
+// (or, between releases on the same date, the one with the most tracks).
+// Albums are returned in the order Spotify lists them.
+//
+// Supported options: [Market].  Specifying a market greatly reduces the
+// number of duplicate albums.
+func (c *Client) GetArtistDiscography(ctx context.Context, artistID ID, ts []AlbumType, opts ...RequestOption) ([]DiscographyAlbum, error) {
+	ctx = c.withOperation(ctx, "GetArtistDiscography")
+	page, err := c.GetArtistAlbums(ctx, artistID, ts, append([]RequestOption{Limit(50)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the editions of each album, keeping the order in which the
+	// albums were first listed.
+	var groups [][]SimpleAlbum
+	index := map[string]int{}
+	for album, err := range Items(ctx, c, page) {
+		if err != nil {
+			return nil, err
+		}
+		key := album.AlbumType + "|" + editionKey(album.Name)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], album)
+	}
+
+	ids := make([]ID, len(groups))
+	editions := make([][]SimpleAlbum, len(groups))
+	for i, group := range groups {
+		best := 0
+		for j, album := range group[1:] {
+			if isPreferredEdition(album, group[best]) {
+				best = j + 1
+			}
+		}
+		ids[i] = group[best].ID
+		editions[i] = append(append([]SimpleAlbum(nil), group[:best]...), group[best+1:]...)
+	}
+
+	albums, err := c.GetAlbums(ctx, ids, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]DiscographyAlbum, 0, len(albums))
+	for i, album := range albums {
+		if album == nil {
+			continue
+		}
+		tracks, err := FetchAll(ctx, c, &album.Tracks)
+		if err != nil {
+			return nil, err
+		}
+		album.Tracks.Tracks = tracks
+		album.Tracks.Next = ""
+		result = append(result, DiscographyAlbum{FullAlbum: *album, Editions: editions[i]})
+	}
+	return result, nil
+}
+
+// isPreferredEdition reports whether album is preferred over other as the
+// edition that represents both of them.
+func isPreferredEdition(album, other SimpleAlbum) bool {
+	released, otherReleased := album.ReleaseDateTime(), other.ReleaseDateTime()
+	if !released.Equal(otherReleased) {
+		return released.Before(otherReleased)
+	}
+	return album.TotalTracks > other.TotalTracks
+}
+
+// Qualifiers at the end of an album name, such as "(Deluxe Edition)" or
+// " - 2011 Remaster".  Bracketed qualifiers are removed first.
+var (
+	bracketQualifier = regexp.MustCompile(`\s*(\([^)]*\)|\[[^\]]*\])$`)
+	dashQualifier    = regexp.MustCompile(`\s-\s[^-]*$`)
+)
+
+// editionWords are words that mark a qualifier as naming an edition.
+var editionWords = []string{
+	"anniversary", "bonus", "clean", "deluxe", "edition", "expanded",
+	"explicit", "reissue", "remaster", "special", "version",
+}
+
+// editionKey returns the name of an album without any qualifiers that
+// name its edition, so that editions of the same album share a key.
+func editionKey(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for {
+		loc := bracketQualifier.FindStringIndex(key)
+		if loc == nil {
+			loc = dashQualifier.FindStringIndex(key)
+		}
+		if loc == nil || !isEditionQualifier(key[loc[0]:]) {
+			return key
+		}
+		key = strings.TrimSpace(key[:loc[0]])
+	}
+}
+
+func isEditionQualifier(qualifier string) bool {
+	for _, word := range editionWords {
+		if strings.Contains(qualifier, word) {
+			return true
+		}
+	}
+	return false
+}
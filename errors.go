@@ -0,0 +1,94 @@
+package spotify
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeader is the response header that identifies a request in
+// Spotify's logs.
+const requestIDHeader = "X-Request-Id"
+
+// Sentinel errors that an [Error] returned by the Web API can be matched
+// against with [errors.Is].
+var (
+	// ErrBadRequest matches errors caused by a malformed request (HTTP 400).
+	ErrBadRequest = errors.New("spotify: bad request")
+	// ErrUnauthorized matches errors caused by a missing, invalid or
+	// expired access token (HTTP 401).
+	ErrUnauthorized = errors.New("spotify: unauthorized")
+	// ErrTokenExpired matches errors caused by an expired access token.
+	// Every error matching ErrTokenExpired also matches [ErrUnauthorized].
+	ErrTokenExpired = errors.New("spotify: access token expired")
+	// ErrForbidden matches requests that the user or application is not
+	// allowed to make (HTTP 403).
+	ErrForbidden = errors.New("spotify: forbidden")
+	// ErrPremiumRequired matches player requests that need a Spotify
+	// Premium subscription.
+	ErrPremiumRequired = errors.New("spotify: premium required")
+	// ErrNotFound matches requests for resources that don't exist (HTTP 404).
+	ErrNotFound = errors.New("spotify: not found")
+	// ErrNoActiveDevice matches player requests made while the user has no
+	// active device.
+	ErrNoActiveDevice = errors.New("spotify: no active device")
+	// ErrRateLimited matches requests rejected by rate limiting (HTTP 429).
+	ErrRateLimited = errors.New("spotify: rate limited")
+	// ErrServerError matches failures on Spotify's side (HTTP 5xx).
+	ErrServerError = errors.New("spotify: server error")
+)
+
+// ErrorReason is the [reason] reported by the player endpoints to explain
+// why a request failed.
+//
+// [reason]: https://developer.spotify.com/documentation/web-api/concepts/api-calls#player-error-reasons
+type ErrorReason string
+
+// ErrorReason values returned by the player endpoints.
+const (
+	ReasonNoPrevTrack           ErrorReason = "NO_PREV_TRACK"
+	ReasonNoNextTrack           ErrorReason = "NO_NEXT_TRACK"
+	ReasonNoSpecificTrack       ErrorReason = "NO_SPECIFIC_TRACK"
+	ReasonAlreadyPaused         ErrorReason = "ALREADY_PAUSED"
+	ReasonNotPaused             ErrorReason = "NOT_PAUSED"
+	ReasonNotPlayingLocally     ErrorReason = "NOT_PLAYING_LOCALLY"
+	ReasonNotPlayingTrack       ErrorReason = "NOT_PLAYING_TRACK"
+	ReasonNotPlayingContext     ErrorReason = "NOT_PLAYING_CONTEXT"
+	ReasonEndlessContext        ErrorReason = "ENDLESS_CONTEXT"
+	ReasonContextDisallow       ErrorReason = "CONTEXT_DISALLOW"
+	ReasonAlreadyPlaying        ErrorReason = "ALREADY_PLAYING"
+	ReasonRateLimited           ErrorReason = "RATE_LIMITED"
+	ReasonRemoteControlDisallow ErrorReason = "REMOTE_CONTROL_DISALLOW"
+	ReasonDeviceNotControllable ErrorReason = "DEVICE_NOT_CONTROLLABLE"
+	ReasonVolumeControlDisallow ErrorReason = "VOLUME_CONTROL_DISALLOW"
+	ReasonNoActiveDevice        ErrorReason = "NO_ACTIVE_DEVICE"
+	ReasonPremiumRequired       ErrorReason = "PREMIUM_REQUIRED"
+	ReasonUnknown               ErrorReason = "UNKNOWN"
+)
+
+// Is reports whether e matches one of the sentinel errors of this package,
+// based on its HTTP status and reason.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.Status == http.StatusUnauthorized
+	case ErrTokenExpired:
+		return e.Status == http.StatusUnauthorized &&
+			strings.Contains(strings.ToLower(e.Message), "expired")
+	case ErrForbidden:
+		return e.Status == http.StatusForbidden
+	case ErrPremiumRequired:
+		return e.Reason == ReasonPremiumRequired
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrNoActiveDevice:
+		return e.Reason == ReasonNoActiveDevice
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests || e.Reason == ReasonRateLimited
+	case ErrServerError:
+		return e.Status >= 500 && e.Status <= 599
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	testTable := []struct {
+		Name    string
+		Status  int
+		Body    string
+		Matches []error
+		Misses  []error
+	}{
+		{
+			"premium required",
+			http.StatusForbidden,
+			`{"error": {"status": 403, "message": "Player command failed: Premium required", "reason": "PREMIUM_REQUIRED"}}`,
+			[]error{ErrForbidden, ErrPremiumRequired},
+			[]error{ErrNotFound, ErrNoActiveDevice, ErrUnauthorized},
+		},
+		{
+			"no active device",
+			http.StatusNotFound,
+			`{"error": {"status": 404, "message": "Player command failed: No active device found", "reason": "NO_ACTIVE_DEVICE"}}`,
+			[]error{ErrNotFound, ErrNoActiveDevice},
+			[]error{ErrPremiumRequired, ErrForbidden},
+		},
+		{
+			"not found",
+			http.StatusNotFound,
+			`{"error": {"status": 404, "message": "Non existing id"}}`,
+			[]error{ErrNotFound},
+			[]error{ErrNoActiveDevice, ErrBadRequest},
+		},
+		{
+			"token expired",
+			http.StatusUnauthorized,
+			`{"error": {"status": 401, "message": "The access token expired"}}`,
+			[]error{ErrUnauthorized, ErrTokenExpired},
+			[]error{ErrForbidden},
+		},
+		{
+			"invalid token",
+			http.StatusUnauthorized,
+			`{"error": {"status": 401, "message": "Invalid access token"}}`,
+			[]error{ErrUnauthorized},
+			[]error{ErrTokenExpired},
+		},
+		{
+			"rate limited",
+			http.StatusTooManyRequests,
+			`{"error": {"status": 429, "message": "API rate limit exceeded"}}`,
+			[]error{ErrRateLimited},
+			[]error{ErrServerError},
+		},
+		{
+			"server error",
+			http.StatusBadGateway,
+			`{"error": {"status": 502, "message": "Bad gateway."}}`,
+			[]error{ErrServerError},
+			[]error{ErrRateLimited, ErrNotFound},
+		},
+	}
+
+	for _, tt := range testTable {
+		t.Run(tt.Name, func(t *testing.T) {
+			client, server := testClientString(tt.Status, tt.Body)
+			defer server.Close()
+
+			err := client.Play(context.Background())
+			for _, target := range tt.Matches {
+				if !errors.Is(err, target) {
+					t.Errorf("Expected %v to match %v", err, target)
+				}
+			}
+			for _, target := range tt.Misses {
+				if errors.Is(err, target) {
+					t.Errorf("Expected %v not to match %v", err, target)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorRequestDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "abc123")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `{"error": {"status": 404, "message": "Player command failed: No active device found", "reason": "NO_ACTIVE_DEVICE"}}`)
+	}))
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/v1/"))
+
+	err := client.Pause(context.Background())
+	var serr Error
+	if !errors.As(err, &serr) {
+		t.Fatalf("Expected spotify Error, got %T", err)
+	}
+	if serr.Reason != ReasonNoActiveDevice {
+		t.Errorf("Expected reason %s, got %s", ReasonNoActiveDevice, serr.Reason)
+	}
+	if serr.Method != http.MethodPut {
+		t.Errorf("Expected method PUT, got %s", serr.Method)
+	}
+	if serr.Endpoint != "/v1/me/player/pause" {
+		t.Errorf("Expected endpoint /v1/me/player/pause, got %s", serr.Endpoint)
+	}
+	if serr.RequestID != "abc123" {
+		t.Errorf("Expected request ID abc123, got %s", serr.RequestID)
+	}
+	if !strings.Contains(serr.Error(), "No active device found") {
+		t.Errorf("Unexpected error message: %s", serr.Error())
+	}
+}
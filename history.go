@@ -0,0 +1,266 @@
+package spotify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// HistoryStore persists the listening history archived by a
+// [HistoryArchiver].
+type HistoryStore interface {
+	// Add stores the items that aren't stored yet, and returns how many it
+	// stored.  An item is already stored if a stored item has the same play
+	// time, to the millisecond, and track ID.  Overlapping syncs may add the
+	// same items, so the check must be atomic with storing them.
+	Add(ctx context.Context, items []RecentlyPlayedItem) (int, error)
+	// Latest returns the time at which the most recent stored item was
+	// played, or the zero time if the store is empty.
+	Latest(ctx context.Context) (time.Time, error)
+	// Range returns the stored items played at or after from and before to,
+	// oldest first.
+	Range(ctx context.Context, from, to time.Time) ([]RecentlyPlayedItem, error)
+}
+
+// ArchiveOption is an option for [NewHistoryArchiver].
+type ArchiveOption func(*HistoryArchiver)
+
+// ArchiveInterval sets how often [HistoryArchiver.Run] syncs.  The default
+// is every 30 minutes, which is well within the 50 items that Spotify
+// remembers.
+func ArchiveInterval(d time.Duration) ArchiveOption {
+	return func(a *HistoryArchiver) {
+		a.interval = d
+	}
+}
+
+// HistoryArchiver copies the user's recently played tracks to a
+// [HistoryStore].  Spotify only remembers the last 50 tracks, so syncing
+// regularly keeps the history that would otherwise be lost.
+//
+// Reading the history requires the [ScopeUserReadRecentlyPlayed] scope.
+type HistoryArchiver struct {
+	client   *Client
+	store    HistoryStore
+	interval time.Duration
+}
+
+// NewHistoryArchiver creates an archiver that stores the history of the
+// user that client is authorized for in store.
+func NewHistoryArchiver(client *Client, store HistoryStore, opts ...ArchiveOption) *HistoryArchiver {
+	a := &HistoryArchiver{
+		client:   client,
+		store:    store,
+		interval: 30 * time.Minute,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Sync fetches the tracks played since the most recent stored item and adds
+// them to the store.  Tracks with the same play time and ID as a stored
+// item are skipped, so it's safe to sync as often as needed, for example
+// from a cron job, and syncs may overlap as long as the store is only
+// shared within a process.  It returns the number of items added.
+func (a *HistoryArchiver) Sync(ctx context.Context) (int, error) {
+	latest, err := a.store.Latest(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	opt := &RecentlyPlayedOptions{Limit: 50}
+	seen := map[historyKey]bool{}
+	if !latest.IsZero() {
+		opt.AfterEpochMs = latest.UnixMilli()
+		// Items played in the same millisecond as the cursor may be
+		// returned again.
+		stored, err := a.store.Range(ctx, latest, latest.Add(time.Millisecond))
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range stored {
+			seen[keyOf(item)] = true
+		}
+	}
+
+	items, err := a.client.PlayerRecentlyPlayedOpt(ctx, opt)
+	if err != nil {
+		return 0, err
+	}
+
+	var added []RecentlyPlayedItem
+	for _, item := range items {
+		key := keyOf(item)
+		if item.PlayedAt.Before(latest) || seen[key] {
+			continue
+		}
+		seen[key] = true
+		added = append(added, item)
+	}
+	if len(added) == 0 {
+		return 0, nil
+	}
+	// Spotify returns the most recent items first.
+	slices.SortStableFunc(added, func(a, b RecentlyPlayedItem) int {
+		return a.PlayedAt.Compare(b.PlayedAt)
+	})
+	return a.store.Add(ctx, added)
+}
+
+// Run syncs immediately and then at the configured interval until ctx is
+// done.  Rate limiting, server and network errors are ignored until the
+// next sync; any other error stops Run and is returned.  Otherwise, Run
+// returns the context's error.
+func (a *HistoryArchiver) Run(ctx context.Context) error {
+	for {
+		if _, err := a.Sync(ctx); err != nil && ctx.Err() == nil && !isTransientPlayerError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(a.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// historyKey identifies a play of a track.
+type historyKey struct {
+	playedAt int64
+	id       ID
+}
+
+func keyOf(item RecentlyPlayedItem) historyKey {
+	return historyKey{item.PlayedAt.UnixMilli(), item.Track.ID}
+}
+
+// FileHistoryStore is a [HistoryStore] that appends items to a file as one
+// JSON object per line.  It is safe for concurrent use within a process, but
+// not by several processes at once.
+type FileHistoryStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileHistoryStore creates a store for the file at path, which is
+// created when the first items are added.
+func NewFileHistoryStore(path string) *FileHistoryStore {
+	return &FileHistoryStore{path: path}
+}
+
+// Add appends the items that aren't in the file yet.
+func (s *FileHistoryStore) Add(ctx context.Context, items []RecentlyPlayedItem) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	seen := make(map[historyKey]bool, len(stored))
+	for _, item := range stored {
+		seen[keyOf(item)] = true
+	}
+	var added []RecentlyPlayedItem
+	for _, item := range items {
+		if key := keyOf(item); !seen[key] {
+			seen[key] = true
+			added = append(added, item)
+		}
+	}
+	if len(added) == 0 {
+		return 0, nil
+	}
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, item := range added {
+		if err := enc.Encode(item); err != nil {
+			f.Close()
+			return 0, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	return len(added), f.Close()
+}
+
+// Latest returns the time at which the most recent item in the file was
+// played.
+func (s *FileHistoryStore) Latest(ctx context.Context) (time.Time, error) {
+	s.mu.Lock()
+	items, err := s.read()
+	s.mu.Unlock()
+	if err != nil {
+		return time.Time{}, err
+	}
+	var latest time.Time
+	for _, item := range items {
+		if item.PlayedAt.After(latest) {
+			latest = item.PlayedAt
+		}
+	}
+	return latest, nil
+}
+
+// Range returns the items in the file played at or after from and before
+// to, oldest first.
+func (s *FileHistoryStore) Range(ctx context.Context, from, to time.Time) ([]RecentlyPlayedItem, error) {
+	s.mu.Lock()
+	items, err := s.read()
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	var result []RecentlyPlayedItem
+	for _, item := range items {
+		if !item.PlayedAt.Before(from) && item.PlayedAt.Before(to) {
+			result = append(result, item)
+		}
+	}
+	slices.SortStableFunc(result, func(a, b RecentlyPlayedItem) int {
+		return a.PlayedAt.Compare(b.PlayedAt)
+	})
+	return result, nil
+}
+
+// read returns all items in the file, or none if it doesn't exist yet.  The
+// caller must hold s.mu.
+func (s *FileHistoryStore) read() ([]RecentlyPlayedItem, error) {
+	f, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []RecentlyPlayedItem
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		var item RecentlyPlayedItem
+		if err := dec.Decode(&item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
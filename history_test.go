@@ -0,0 +1,166 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// historyTestClient serves each of bodies in turn as the recently played
+// tracks, and records the query of each request.
+func historyTestClient(t *testing.T, bodies ...[]string) (*Client, *httptest.Server, func() []string) {
+	served := 0
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		if r.URL.Path != "/me/player/recently-played" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		items := bodies[min(served, len(bodies)-1)]
+		served++
+		fmt.Fprintf(w, `{"items": [%s], "cursors": null}`, strings.Join(items, ","))
+		return r.URL.RawQuery
+	})
+}
+
+func playedJSON(id string, at time.Time) string {
+	return fmt.Sprintf(`{"track": {"id": %q, "name": "name of %s"}, "played_at": %q}`, id, id, at.Format(time.RFC3339Nano))
+}
+
+func TestHistoryArchiverSync(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	client, server, queries := historyTestClient(t,
+		[]string{playedJSON("b", t0.Add(4*time.Minute)), playedJSON("a", t0)},
+		[]string{playedJSON("c", t0.Add(8*time.Minute)), playedJSON("b", t0.Add(4*time.Minute)), playedJSON("b", t0.Add(4*time.Minute))},
+		[]string{},
+	)
+	defer server.Close()
+
+	store := NewFileHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	a := NewHistoryArchiver(client, store)
+	ctx := context.Background()
+
+	for i, want := range []int{2, 1, 0} {
+		n, err := a.Sync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != want {
+			t.Errorf("Sync %d: expected %d items added, got %d", i, want, n)
+		}
+	}
+
+	after := fmt.Sprint(t0.Add(4 * time.Minute).UnixMilli())
+	want := []string{"limit=50", "after=" + after + "&limit=50", "after=" + fmt.Sprint(t0.Add(8*time.Minute).UnixMilli()) + "&limit=50"}
+	if got := queries(); strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Expected queries %v, got %v", want, got)
+	}
+
+	items, err := store.Range(ctx, t0, t0.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.Track.ID.String())
+	}
+	if got := strings.Join(ids, ","); got != "a,b,c" {
+		t.Errorf("Expected a,b,c oldest first, got %s", got)
+	}
+	if items[1].Track.Name != "name of b" || !items[1].PlayedAt.Equal(t0.Add(4*time.Minute)) {
+		t.Errorf("Unexpected item %+v", items[1])
+	}
+}
+
+func TestFileHistoryStoreRange(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := NewFileHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	ctx := context.Background()
+
+	latest, err := store.Latest(ctx)
+	if err != nil || !latest.IsZero() {
+		t.Fatalf("Expected an empty store, got %s, %v", latest, err)
+	}
+
+	var items []RecentlyPlayedItem
+	for i := range 5 {
+		items = append(items, RecentlyPlayedItem{
+			Track:    SimpleTrack{ID: ID(fmt.Sprint(i))},
+			PlayedAt: t0.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	if _, err := store.Add(ctx, items[3:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Add(ctx, items[:3]); err != nil {
+		t.Fatal(err)
+	}
+
+	latest, err = store.Latest(ctx)
+	if err != nil || !latest.Equal(items[4].PlayedAt) {
+		t.Errorf("Expected latest %s, got %s, %v", items[4].PlayedAt, latest, err)
+	}
+	got, err := store.Range(ctx, t0.Add(time.Hour), t0.Add(3*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Track.ID != "1" || got[1].Track.ID != "2" {
+		t.Errorf("Expected tracks 1 and 2, got %+v", got)
+	}
+}
+
+func TestHistoryArchiverOverlappingSyncs(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	client, server, _ := historyTestClient(t,
+		[]string{playedJSON("b", t0.Add(4*time.Minute)), playedJSON("a", t0)},
+	)
+	defer server.Close()
+
+	store := NewFileHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	a := NewHistoryArchiver(client, store)
+	ctx := context.Background()
+
+	var (
+		wg    sync.WaitGroup
+		added atomic.Int32
+	)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := a.Sync(ctx)
+			if err != nil {
+				t.Error(err)
+			}
+			added.Add(int32(n))
+		}()
+	}
+	wg.Wait()
+
+	if got := added.Load(); got != 2 {
+		t.Errorf("Expected 2 items added in total, got %d", got)
+	}
+	items, err := store.Range(ctx, t0, t0.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 {
+		t.Errorf("Expected 2 stored items, got %d", len(items))
+	}
+}
+
+func TestHistoryArchiverRunStopsOnError(t *testing.T) {
+	client, server := testClientString(http.StatusUnauthorized, `{"error": {"status": 401, "message": "The access token expired"}}`)
+	defer server.Close()
+
+	store := NewFileHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	err := NewHistoryArchiver(client, store, ArchiveInterval(time.Millisecond)).Run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("Expected the authorization error, got %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// ItemPage is a page of items of type T, such as a [SavedTrackPage] or a
+// [PlaylistItemPage].  It is implemented by every paging object in this
+// package, including the pages of a [SearchResult] and cursor-based pages
+// such as [FullArtistCursorPage] and [RecentlyPlayedResult].
+type ItemPage[T any] interface {
+	items() []T
+	nextURL() string
+}
+
+// OffsetPage is an offset-based page of items of type T.  It is implemented
+// by every paging object in this package except the cursor-based ones.
+type OffsetPage[T any] interface {
+	ItemPage[T]
+	base() *basePage
+}
+
+// IterOption configures an iterator returned by [Items], or a call to
+// [FetchAll].
+type IterOption func(o *iterOptions)
+
+type iterOptions struct {
+	maxItems    int
+	concurrency int
+	partial     bool
+}
+
+// defaultConcurrency is the number of requests sent at once by [FetchAll]
+// and by methods that get several items, such as [Client.GetTracks].
+const defaultConcurrency = 4
+
+// MaxItems limits the total number of items yielded by an iterator to n.
+// No further pages are fetched once n items have been yielded.
+func MaxItems(n int) IterOption {
+	return func(o *iterOptions) {
+		o.maxItems = n
+	}
+}
+
+// Concurrency sets the maximum number of pages that [FetchAll] fetches at
+// once.  It defaults to 4.  Requests are still subject to the client's
+// [RateLimiter], if any.
+func Concurrency(n int) IterOption {
+	return func(o *iterOptions) {
+		o.concurrency = n
+	}
+}
+
+// PartialResults makes [FetchAll] keep fetching the remaining pages when a
+// page can't be fetched, and return the items of the pages that could be
+// fetched along with the errors.  By default, FetchAll stops at the first
+// error and returns no items.
+func PartialResults() IterOption {
+	return func(o *iterOptions) {
+		o.partial = true
+	}
+}
+
+// Items returns an iterator over the items of page and of every page that
+// follows it, fetching the following pages with c as they are needed:
+//
+//	for track, err := range spotify.Items(ctx, client, savedTracks) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// page itself is not modified.  If fetching a page fails, the error is
+// yielded and the iteration ends.  No request is sent once the loop is
+// exited, so breaking out of it early is safe.  A nil page yields nothing.
+func Items[T any](ctx context.Context, c *Client, page ItemPage[T], opts ...IterOption) iter.Seq2[T, error] {
+	var o iterOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return func(yield func(T, error) bool) {
+		if page == nil || reflect.ValueOf(page).IsNil() {
+			return
+		}
+		ctx := c.withOperation(ctx, "Items")
+
+		yielded := 0
+		for p := page; ; {
+			for _, item := range p.items() {
+				if o.maxItems > 0 && yielded >= o.maxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+
+			next := p.nextURL()
+			if next == "" || (o.maxItems > 0 && yielded >= o.maxItems) {
+				return
+			}
+			p = reflect.New(reflect.TypeOf(p).Elem()).Interface().(ItemPage[T])
+			if err := c.getPage(ctx, next, p); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// FetchAll returns the items of page and of every page that follows it, in
+// order.  Unlike [Items], it uses the offset, limit and total of page to
+// work out which pages remain, and fetches them concurrently:
+//
+//	tracks, err := spotify.FetchAll(ctx, client, playlistItems, spotify.Concurrency(8))
+//
+// page itself is not modified.  Supported options: [MaxItems],
+// [Concurrency], [PartialResults].
+func FetchAll[T any](ctx context.Context, c *Client, page OffsetPage[T], opts ...IterOption) ([]T, error) {
+	o := iterOptions{concurrency: defaultConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	if page == nil || reflect.ValueOf(page).IsNil() {
+		return nil, nil
+	}
+
+	items := append([]T(nil), page.items()...)
+	first := page.base()
+	end := int(first.Total)
+	if o.maxItems > 0 {
+		end = min(end, int(first.Offset)+o.maxItems)
+	}
+	if first.Next == "" || first.Limit <= 0 || int(first.Offset)+len(items) >= end {
+		return truncate(items, o.maxItems), nil
+	}
+
+	// The URL of the next page carries the query of the original request,
+	// so it's reused for every remaining page with a different offset.
+	next, err := url.Parse(first.Next)
+	if err != nil {
+		return nil, err
+	}
+	var offsets []int
+	for offset := int(first.Offset) + int(first.Limit); offset < end; offset += int(first.Limit) {
+		offsets = append(offsets, offset)
+	}
+
+	ctx, cancel := context.WithCancel(c.withOperation(ctx, "FetchAll"))
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, o.concurrency)
+		pages    = make([][]T, len(offsets))
+		errs     = make([]error, len(offsets))
+	)
+fetch:
+	for i, offset := range offsets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break fetch
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			pageURL := *next
+			query := pageURL.Query()
+			query.Set("offset", strconv.Itoa(offset))
+			pageURL.RawQuery = query.Encode()
+
+			p := reflect.New(reflect.TypeOf(page).Elem()).Interface().(OffsetPage[T])
+			if err := c.getPage(ctx, pageURL.String(), p); err != nil {
+				errs[i] = fmt.Errorf("spotify: fetching items at offset %d: %w", offset, err)
+				if !o.partial {
+					once.Do(func() {
+						firstErr = errs[i]
+						cancel()
+					})
+				}
+				return
+			}
+			pages[i] = p.items()
+		}()
+	}
+	wg.Wait()
+
+	if !o.partial {
+		if firstErr == nil {
+			// The caller's context was cancelled.
+			firstErr = ctx.Err()
+		}
+		if firstErr != nil {
+			return nil, firstErr
+		}
+	}
+	for _, p := range pages {
+		items = append(items, p...)
+	}
+	return truncate(items, o.maxItems), errors.Join(append(errs, ctx.Err())...)
+}
+
+// truncate returns the first n items, or all of them if n is zero.
+func truncate[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
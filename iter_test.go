@@ -0,0 +1,309 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pagesTestServer serves three pages of two tracks each at /pages/{n}.  If
+// wrapped is set, the paging objects are wrapped in a "tracks" object as
+// search results are.
+func pagesTestServer(wrapped bool) (*httptest.Server, *int32) {
+	var requests int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/pages/"))
+		if err != nil || n > 3 {
+			http.Error(w, `{"error": {"status": 404, "message": "Not found"}}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testPage(server.URL, n, wrapped)))
+	}))
+	return server, &requests
+}
+
+func testPage(baseURL string, n int, wrapped bool) string {
+	next := "null"
+	if n < 3 {
+		next = fmt.Sprintf("%q", fmt.Sprintf("%s/pages/%d", baseURL, n+1))
+	}
+	page := fmt.Sprintf(`{"limit": 2, "offset": %d, "total": 6, "next": %s, "items": [{"name": "track %d"}, {"name": "track %d"}]}`,
+		(n-1)*2, next, n*2-1, n*2)
+	if wrapped {
+		return `{"tracks": ` + page + `}`
+	}
+	return page
+}
+
+func firstPage(t *testing.T, baseURL string) *FullTrackPage {
+	var page FullTrackPage
+	if err := json.Unmarshal([]byte(testPage(baseURL, 1, false)), &page); err != nil {
+		t.Fatal(err)
+	}
+	return &page
+}
+
+func TestItems(t *testing.T) {
+	for _, wrapped := range []bool{false, true} {
+		t.Run(fmt.Sprintf("wrapped=%t", wrapped), func(t *testing.T) {
+			server, requests := pagesTestServer(wrapped)
+			defer server.Close()
+			client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+			page := firstPage(t, server.URL)
+			var names []string
+			for track, err := range Items(context.Background(), client, page) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, track.Name)
+			}
+
+			want := "track 1,track 2,track 3,track 4,track 5,track 6"
+			if got := strings.Join(names, ","); got != want {
+				t.Errorf("Expected %s, got %s", want, got)
+			}
+			if got := atomic.LoadInt32(requests); got != 2 {
+				t.Errorf("Expected 2 requests, got %d", got)
+			}
+			if page.Tracks[0].Name != "track 1" {
+				t.Errorf("Expected the first page to be unmodified")
+			}
+		})
+	}
+}
+
+func TestItemsStopsEarly(t *testing.T) {
+	server, requests := pagesTestServer(false)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	count := 0
+	for _, err := range Items(context.Background(), client, firstPage(t, server.URL)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestItemsMaxItems(t *testing.T) {
+	server, requests := pagesTestServer(false)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	count := 0
+	for _, err := range Items(context.Background(), client, firstPage(t, server.URL), MaxItems(4)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	if count != 4 {
+		t.Errorf("Expected 4 items, got %d", count)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestItemsError(t *testing.T) {
+	server, _ := pagesTestServer(false)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	page := firstPage(t, server.URL)
+	page.Next = server.URL + "/pages/9"
+	var errs []error
+	count := 0
+	for _, err := range Items(context.Background(), client, page) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	if count != 2 || len(errs) != 1 {
+		t.Errorf("Expected 2 items and 1 error, got %d items and %v", count, errs)
+	}
+}
+
+func TestItemsNilPage(t *testing.T) {
+	var result SearchResult
+	for range Items(context.Background(), &Client{}, result.Artists) {
+		t.Fatal("Expected no items")
+	}
+}
+
+// offsetTestServer serves a playlist of total items, limit at a time, and
+// fails the request for the page at offset failAt.  It records the highest
+// number of concurrent requests.
+func offsetTestServer(total, limit, failAt int) (*httptest.Server, *int32) {
+	var active, maxActive int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("market") != "SE" {
+			http.Error(w, `{"error": {"status": 400, "message": "Missing market"}}`, http.StatusBadRequest)
+			return
+		}
+		if offset == failAt {
+			http.Error(w, `{"error": {"status": 500, "message": "Server error"}}`, http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(offsetPage(server.URL, offset, limit, total)))
+	}))
+	return server, &maxActive
+}
+
+func offsetPage(baseURL string, offset, limit, total int) string {
+	next := "null"
+	if offset+limit < total {
+		next = fmt.Sprintf(`"%s/playlists/p/items?limit=%d&market=SE&offset=%d"`, baseURL, limit, offset+limit)
+	}
+	var items []string
+	for i := offset; i < min(offset+limit, total); i++ {
+		items = append(items, fmt.Sprintf(`{"item": {"type": "track", "name": "track %d"}}`, i))
+	}
+	return fmt.Sprintf(`{"limit": %d, "offset": %d, "total": %d, "next": %s, "items": [%s]}`,
+		limit, offset, total, next, strings.Join(items, ","))
+}
+
+func firstOffsetPage(t *testing.T, baseURL string, limit, total int) *PlaylistItemPage {
+	var page PlaylistItemPage
+	if err := json.Unmarshal([]byte(offsetPage(baseURL, 0, limit, total)), &page); err != nil {
+		t.Fatal(err)
+	}
+	return &page
+}
+
+func TestFetchAll(t *testing.T) {
+	server, maxActive := offsetTestServer(25, 2, -1)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	items, err := FetchAll(context.Background(), client, firstOffsetPage(t, server.URL, 2, 25), Concurrency(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 25 {
+		t.Fatalf("Expected 25 items, got %d", len(items))
+	}
+	for i, item := range items {
+		if want := fmt.Sprintf("track %d", i); item.Item.Track.Name != want {
+			t.Errorf("Expected %s at position %d, got %s", want, i, item.Item.Track.Name)
+		}
+	}
+	if got := atomic.LoadInt32(maxActive); got > 3 || got < 2 {
+		t.Errorf("Expected up to 3 concurrent requests, got %d", got)
+	}
+}
+
+func TestFetchAllMaxItems(t *testing.T) {
+	server, _ := offsetTestServer(25, 2, 10)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	// The page at offset 10 fails, but isn't needed.
+	items, err := FetchAll(context.Background(), client, firstOffsetPage(t, server.URL, 2, 25), MaxItems(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 7 {
+		t.Errorf("Expected 7 items, got %d", len(items))
+	}
+}
+
+func TestFetchAllErrors(t *testing.T) {
+	server, _ := offsetTestServer(10, 2, 4)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	items, err := FetchAll(context.Background(), client, firstOffsetPage(t, server.URL, 2, 10))
+	if !errors.Is(err, ErrServerError) || items != nil {
+		t.Errorf("Expected server error and no items, got %d items and %v", len(items), err)
+	}
+
+	items, err = FetchAll(context.Background(), client, firstOffsetPage(t, server.URL, 2, 10), PartialResults())
+	if !errors.Is(err, ErrServerError) {
+		t.Errorf("Expected server error, got %v", err)
+	}
+	if len(items) != 8 {
+		t.Fatalf("Expected 8 items, got %d", len(items))
+	}
+	if items[4].Item.Track.Name != "track 6" {
+		t.Errorf("Expected the failed page to be skipped, got %s", items[4].Item.Track.Name)
+	}
+}
+
+func TestItemsOperation(t *testing.T) {
+	server, _ := pagesTestServer(false)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	var operations []string
+	WithMiddleware(MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		operations = append(operations, call.Operation)
+		return next(call)
+	}))(client)
+
+	for _, err := range Items(context.Background(), client, firstPage(t, server.URL)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := strings.Join(operations, ","); got != "Items,Items" {
+		t.Errorf("Expected operation Items for both pages, got %q", got)
+	}
+}
+
+func TestFetchAllOperation(t *testing.T) {
+	server, _ := offsetTestServer(6, 2, -1)
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	var (
+		mu         sync.Mutex
+		operations []string
+	)
+	WithMiddleware(MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		mu.Lock()
+		operations = append(operations, call.Operation)
+		mu.Unlock()
+		return next(call)
+	}))(client)
+
+	if _, err := FetchAll(context.Background(), client, firstOffsetPage(t, server.URL, 2, 6)); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(operations, ","); got != "FetchAll,FetchAll" {
+		t.Errorf("Expected operation FetchAll for both pages, got %q", got)
+	}
+}
@@ -11,10 +11,11 @@ import (
 
 // SaveToLibrary saves one or more items to the current user's library.
 // This call accepts Spotify URIs (e.g. "spotify:track:xxx", "spotify:album:xxx",
-// "spotify:artist:xxx", "spotify:playlist:xxx").
+// "spotify:artist:xxx", "spotify:playlist:xxx", "spotify:audiobook:xxx").
 //
 // Appropriate scopes need to be passed depending on the entities being saved.
 func (c *Client) SaveToLibrary(ctx context.Context, uris ...URI) error {
+	ctx = c.withOperation(ctx, "SaveToLibrary")
 	if l := len(uris); l == 0 {
 		return fmt.Errorf("spotify: at least one URI is required")
 	}
@@ -36,10 +37,11 @@ func (c *Client) SaveToLibrary(ctx context.Context, uris ...URI) error {
 
 // RemoveFromLibrary removes one or more items from the current user's library.
 // This call accepts Spotify URIs (e.g. "spotify:track:xxx", "spotify:album:xxx",
-// "spotify:artist:xxx", "spotify:playlist:xxx").
+// "spotify:artist:xxx", "spotify:playlist:xxx", "spotify:audiobook:xxx").
 //
 // Appropriate scopes need to be passed depending on the entities being removed.
 func (c *Client) RemoveFromLibrary(ctx context.Context, uris ...URI) error {
+	ctx = c.withOperation(ctx, "RemoveFromLibrary")
 	if l := len(uris); l == 0 {
 		return fmt.Errorf("spotify: at least one URI is required")
 	}
@@ -65,6 +67,7 @@ func (c *Client) RemoveFromLibrary(ctx context.Context, uris ...URI) error {
 // The result is returned as a slice of bool values in the same order
 // in which the URIs were specified.
 func (c *Client) UserHasSavedItems(ctx context.Context, uris ...URI) ([]bool, error) {
+	ctx = c.withOperation(ctx, "UserHasSavedItems")
 	if l := len(uris); l == 0 {
 		return nil, fmt.Errorf("spotify: at least one URI is required")
 	}
@@ -0,0 +1,118 @@
+package spotify
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Call describes a single attempt at a Web API request, as seen by a
+// [Middleware].
+type Call struct {
+	// Operation is the name of the Client method, or of a function such as
+	// [Items], that made the request, for example "GetPlaylistItems".
+	Operation string
+	// Request is the HTTP request about to be sent.  Middleware may add
+	// headers to it before passing it on.
+	Request *http.Request
+	// Attempt is the number of this attempt, starting at 1.  Higher
+	// numbers indicate that the request is being retried.
+	Attempt int
+}
+
+// Handler sends the request of a [Call] and returns the response.
+type Handler func(call *Call) (*http.Response, error)
+
+// Middleware intercepts every attempt at a request made by a [Client].
+// Implementations must call next to send the request, unless they want to
+// answer it themselves, and return its response and error.
+type Middleware interface {
+	Handle(call *Call, next Handler) (*http.Response, error)
+}
+
+// MiddlewareFunc is an adapter that allows an ordinary function to be used
+// as a [Middleware].
+type MiddlewareFunc func(call *Call, next Handler) (*http.Response, error)
+
+// Handle calls f(call, next).
+func (f MiddlewareFunc) Handle(call *Call, next Handler) (*http.Response, error) {
+	return f(call, next)
+}
+
+// WithMiddleware configures the Spotify API client to run every request
+// attempt through the provided middleware.  The first middleware is the
+// outermost one, and sees the request first and the response last.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(client *Client) {
+		client.middleware = append(client.middleware, middleware...)
+	}
+}
+
+// LoggingMiddleware returns a [Middleware] that logs the outcome and latency
+// of every request attempt to logger.  Failed attempts are logged at warning
+// level, all others at debug level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next(call)
+		attrs := []slog.Attr{
+			slog.String("operation", call.Operation),
+			slog.String("method", call.Request.Method),
+			slog.String("path", call.Request.URL.Path),
+			slog.Int("attempt", call.Attempt),
+			slog.Duration("latency", time.Since(start)),
+		}
+		level := slog.LevelDebug
+		switch {
+		case err != nil:
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.Any("error", err))
+		case resp.StatusCode >= 400:
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		default:
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		}
+		logger.LogAttrs(call.Request.Context(), level, "spotify request", attrs...)
+		return resp, err
+	})
+}
+
+// send runs call through the client's middleware and sends its request.
+func (c *Client) send(call *Call) (*http.Response, error) {
+	h := func(call *Call) (*http.Response, error) {
+		return c.http.Do(call.Request)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		m, next := c.middleware[i], h
+		h = func(call *Call) (*http.Response, error) {
+			return m.Handle(call, next)
+		}
+	}
+	return h(call)
+}
+
+// operationKey is the context key under which the operation of a request is
+// stored.
+type operationKey struct{}
+
+// withOperation returns a copy of ctx that names the operation of the
+// requests made with it, for the client's middleware.  If ctx already names
+// an operation, it is kept, so that methods implemented with other methods
+// report the method that was called.
+func (c *Client) withOperation(ctx context.Context, operation string) context.Context {
+	if len(c.middleware) == 0 {
+		return ctx
+	}
+	if _, ok := ctx.Value(operationKey{}).(string); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// requestOperation returns the operation named by ctx.
+func requestOperation(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
@@ -0,0 +1,146 @@
+package spotify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/find_track.txt", func(r *http.Request) {
+		if got := r.Header.Get("X-Trace"); got != "outer" {
+			t.Errorf("Expected header injected by middleware, got %q", got)
+		}
+	})
+	defer server.Close()
+
+	var order []string
+	var calls []Call
+	record := func(name string) Middleware {
+		return MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+			order = append(order, name+" before")
+			if name == "outer" {
+				call.Request.Header.Set("X-Trace", name)
+			}
+			resp, err := next(call)
+			order = append(order, name+" after")
+			calls = append(calls, *call)
+			return resp, err
+		})
+	}
+	WithMiddleware(record("outer"), record("inner"))(client)
+
+	if _, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "outer before,inner before,inner after,outer after"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	for _, call := range calls {
+		if call.Operation != "GetTrack" {
+			t.Errorf("Expected operation GetTrack, got %q", call.Operation)
+		}
+		if call.Attempt != 1 {
+			t.Errorf("Expected attempt 1, got %d", call.Attempt)
+		}
+	}
+}
+
+func TestMiddlewareRetries(t *testing.T) {
+	client, server, _ := retryTestClient([]int{http.StatusServiceUnavailable, http.StatusOK})
+	defer server.Close()
+
+	var attempts []int
+	var operations []string
+	WithMiddleware(MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		attempts = append(attempts, call.Attempt)
+		operations = append(operations, call.Operation)
+		return next(call)
+	}))(client)
+
+	if _, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY"); err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Errorf("Expected attempts [1 2], got %v", attempts)
+	}
+	for _, op := range operations {
+		if op != "GetTrack" {
+			t.Errorf("Expected operation GetTrack, got %q", op)
+		}
+	}
+}
+
+func TestOperationNameOfWrappedMethods(t *testing.T) {
+	client, server := testClientString(http.StatusNoContent, "")
+	defer server.Close()
+
+	var operation string
+	WithMiddleware(MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		operation = call.Operation
+		return next(call)
+	}))(client)
+
+	// Play delegates to PlayOpt; the method called by the user is reported.
+	if err := client.Play(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if operation != "Play" {
+		t.Errorf("Expected operation Play, got %q", operation)
+	}
+}
+
+func TestOperationNameOfSeveralItems(t *testing.T) {
+	client, server, _ := batchTestClient()
+	defer server.Close()
+
+	var (
+		mu         sync.Mutex
+		operations []string
+	)
+	WithMiddleware(MiddlewareFunc(func(call *Call, next Handler) (*http.Response, error) {
+		mu.Lock()
+		operations = append(operations, call.Operation)
+		mu.Unlock()
+		return next(call)
+	}))(client)
+
+	// 60 IDs are requested in two chunks, from separate goroutines.
+	ids := make([]ID, 60)
+	for i := range ids {
+		ids[i] = ID(fmt.Sprintf("t%d", i))
+	}
+	if _, err := client.GetTracks(context.Background(), ids); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(operations, ","); got != "GetTracks,GetTracks" {
+		t.Errorf("Expected operation GetTracks for both chunks, got %q", got)
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	client, server := testClientString(http.StatusNotFound, `{"error": {"status": 404, "message": "Non existing id"}}`)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	WithMiddleware(LoggingMiddleware(logger))(client)
+
+	if _, err := client.GetAlbum(context.Background(), "0sNOF9WDwhWunNAHPD3Baj"); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	line := buf.String()
+	for _, want := range []string{"level=WARN", "operation=GetAlbum", "method=GET", "path=/albums/0sNOF9WDwhWunNAHPD3Baj", "attempt=1", "status=404"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("Expected log line to contain %q, got %s", want, line)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package spotify
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -53,6 +54,12 @@ type SavedAlbumPage struct {
 	Albums []SavedAlbum `json:"items"`
 }
 
+// SavedEpisodePage contains [SavedEpisodes] returned by the Web API.
+type SavedEpisodePage struct {
+	basePage
+	Episodes []SavedEpisode `json:"items"`
+}
+
 // SavedShowPage contains [SavedShows] returned by the Web API
 type SavedShowPage struct {
 	basePage
@@ -101,15 +108,86 @@ type SimpleShowPage struct {
 	Shows []FullShow `json:"items"`
 }
 
+// SimpleAudiobookPage contains [SimpleAudiobook] objects returned by the Web API.
+type SimpleAudiobookPage struct {
+	basePage
+	Audiobooks []SimpleAudiobook `json:"items"`
+}
+
+// SimpleChapterPage contains [SimpleChapter] objects returned by the Web API.
+type SimpleChapterPage struct {
+	basePage
+	Chapters []SimpleChapter `json:"items"`
+}
+
+// CategoryPage contains [Category] objects returned by the Web API.
+type CategoryPage struct {
+	basePage
+	Categories []Category `json:"items"`
+}
+
 // pageable is an internal interface for types that support paging
 // by embedding basePage.
 type pageable interface{ canPage() }
 
 func (b *basePage) canPage() {}
 
+func (b *basePage) nextURL() string { return b.Next }
+
+func (b *basePage) base() *basePage { return b }
+
+func (p *FullArtistPage) items() []FullArtist           { return p.Artists }
+func (p *SimpleAlbumPage) items() []SimpleAlbum         { return p.Albums }
+func (p *SavedAlbumPage) items() []SavedAlbum           { return p.Albums }
+func (p *SavedEpisodePage) items() []SavedEpisode       { return p.Episodes }
+func (p *SavedShowPage) items() []SavedShow             { return p.Shows }
+func (p *SimplePlaylistPage) items() []SimplePlaylist   { return p.Playlists }
+func (p *SimpleTrackPage) items() []SimpleTrack         { return p.Tracks }
+func (p *FullTrackPage) items() []FullTrack             { return p.Tracks }
+func (p *SavedTrackPage) items() []SavedTrack           { return p.Tracks }
+func (p *PlaylistTrackPage) items() []PlaylistTrack     { return p.Items }
+func (p *SimpleEpisodePage) items() []EpisodePage       { return p.Episodes }
+func (p *SimpleShowPage) items() []FullShow             { return p.Shows }
+func (p *PlaylistItemPage) items() []PlaylistItem       { return p.Items }
+func (p *SimpleAudiobookPage) items() []SimpleAudiobook { return p.Audiobooks }
+func (p *SimpleChapterPage) items() []SimpleChapter     { return p.Chapters }
+func (p *CategoryPage) items() []Category               { return p.Categories }
+
+// getPage fetches the page of items at url into p.  Some endpoints, such
+// as search, wrap the paging object in another object; the paging object
+// is unwrapped.
+func (c *Client) getPage(ctx context.Context, url string, p interface{}) error {
+	var raw json.RawMessage
+	if err := c.get(ctx, url, &raw); err != nil || len(raw) == 0 {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	if _, ok := fields["items"]; !ok {
+		for _, field := range fields {
+			var inner map[string]json.RawMessage
+			if json.Unmarshal(field, &inner) != nil {
+				continue
+			}
+			if _, ok := inner["items"]; ok {
+				raw = field
+				break
+			}
+		}
+	}
+	return json.Unmarshal(raw, p)
+}
+
 // NextPage fetches the next page of items and writes them into p.
 // It returns [ErrNoMorePages] if p already contains the last page.
+//
+// Pages that the Web API wraps in another object, such as the results of
+// [Client.Search] or [Client.NewReleases], are unwrapped.
 func (c *Client) NextPage(ctx context.Context, p pageable) error {
+	ctx = c.withOperation(ctx, "NextPage")
 	if p == nil || reflect.ValueOf(p).IsNil() {
 		return fmt.Errorf("spotify: p must be a non-nil pointer to a page")
 	}
@@ -128,12 +206,13 @@ func (c *Client) NextPage(ctx context.Context, p pageable) error {
 	zero := reflect.Zero(val.Type())
 	val.Set(zero)
 
-	return c.get(ctx, nextURL, p)
+	return c.getPage(ctx, nextURL, p)
 }
 
 // PreviousPage fetches the previous page of items and writes them into p.
 // It returns [ErrNoMorePages] if p already contains the last page.
 func (c *Client) PreviousPage(ctx context.Context, p pageable) error {
+	ctx = c.withOperation(ctx, "PreviousPage")
 	if p == nil || reflect.ValueOf(p).IsNil() {
 		return fmt.Errorf("spotify: p must be a non-nil pointer to a page")
 	}
@@ -152,5 +231,5 @@ func (c *Client) PreviousPage(ctx context.Context, p pageable) error {
 	zero := reflect.Zero(val.Type())
 	val.Set(zero)
 
-	return c.get(ctx, prevURL, p)
+	return c.getPage(ctx, prevURL, p)
 }
@@ -0,0 +1,157 @@
+package spotify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PlayableItem is a union type for the items that can be played: tracks,
+// episodes and audiobook chapters.  At most one of the fields is set.  If
+// all of them are nil, it's likely that the piece of content is not
+// available in the configured market.
+type PlayableItem struct {
+	Track   *FullTrack
+	Episode *EpisodePage
+	Chapter *Chapter
+}
+
+// PlaylistItemTrack is the former name of [PlayableItem].
+//
+// Deprecated: Use [PlayableItem] instead.
+type PlaylistItemTrack = PlayableItem
+
+// UnmarshalJSON customises the unmarshalling based on the type flags set.
+func (p *PlayableItem) UnmarshalJSON(b []byte) error {
+	// Spotify API will return `track: null`` where the content is not available
+	// in the specified market. We should respect this and just pass the null
+	// up...
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	itemType := struct {
+		Type string `json:"type"`
+	}{}
+
+	err := json.Unmarshal(b, &itemType)
+	if err != nil {
+		return err
+	}
+
+	switch itemType.Type {
+	case "episode":
+		return json.Unmarshal(b, &p.Episode)
+	case "track":
+		return json.Unmarshal(b, &p.Track)
+	case "chapter":
+		return json.Unmarshal(b, &p.Chapter)
+	default:
+		return fmt.Errorf("unrecognized item type: %s", itemType.Type)
+	}
+}
+
+// MarshalJSON encodes the item that is set, or null if there is none.
+func (p PlayableItem) MarshalJSON() ([]byte, error) {
+	switch {
+	case p.Track != nil:
+		return json.Marshal(p.Track)
+	case p.Episode != nil:
+		return json.Marshal(p.Episode)
+	case p.Chapter != nil:
+		return json.Marshal(p.Chapter)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// Type returns the type of the item: "track", "episode" or "chapter".  It
+// returns the empty string if no item is set.
+func (p *PlayableItem) Type() string {
+	switch {
+	case p.Track != nil:
+		return "track"
+	case p.Episode != nil:
+		return "episode"
+	case p.Chapter != nil:
+		return "chapter"
+	default:
+		return ""
+	}
+}
+
+// ID returns the Spotify ID of the item.
+func (p *PlayableItem) ID() ID {
+	switch {
+	case p.Track != nil:
+		return p.Track.ID
+	case p.Episode != nil:
+		return p.Episode.ID
+	case p.Chapter != nil:
+		return p.Chapter.ID
+	default:
+		return ""
+	}
+}
+
+// URI returns the Spotify URI of the item.
+func (p *PlayableItem) URI() URI {
+	switch {
+	case p.Track != nil:
+		return p.Track.URI
+	case p.Episode != nil:
+		return p.Episode.URI
+	case p.Chapter != nil:
+		return p.Chapter.URI
+	default:
+		return ""
+	}
+}
+
+// Name returns the name of the item.
+func (p *PlayableItem) Name() string {
+	switch {
+	case p.Track != nil:
+		return p.Track.Name
+	case p.Episode != nil:
+		return p.Episode.Name
+	case p.Chapter != nil:
+		return p.Chapter.Name
+	default:
+		return ""
+	}
+}
+
+// Duration returns the length of the item.
+func (p *PlayableItem) Duration() time.Duration {
+	switch {
+	case p.Track != nil:
+		return p.Track.TimeDuration()
+	case p.Episode != nil:
+		return time.Duration(p.Episode.Duration_ms) * time.Millisecond
+	case p.Chapter != nil:
+		return time.Duration(p.Chapter.Duration) * time.Millisecond
+	default:
+		return 0
+	}
+}
+
+// Images returns the cover art for the item in various sizes, widest first.
+// For tracks, this is the cover art of the album; for chapters without their
+// own images, it is the cover art of the audiobook.
+func (p *PlayableItem) Images() []Image {
+	switch {
+	case p.Track != nil:
+		return p.Track.Album.Images
+	case p.Episode != nil:
+		return p.Episode.Images
+	case p.Chapter != nil:
+		if len(p.Chapter.Images) == 0 {
+			return p.Chapter.Audiobook.Images
+		}
+		return p.Chapter.Images
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package spotify
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPlayableItem(t *testing.T) {
+	tests := []struct {
+		json     string
+		typ      string
+		name     string
+		duration time.Duration
+		image    string
+	}{
+		{
+			json:     `{"type": "track", "id": "t", "uri": "spotify:track:t", "name": "Typhoons", "duration_ms": 1000, "album": {"images": [{"url": "album.jpg"}]}}`,
+			typ:      "track",
+			name:     "Typhoons",
+			duration: time.Second,
+			image:    "album.jpg",
+		},
+		{
+			json:     `{"type": "episode", "id": "e", "uri": "spotify:episode:e", "name": "112: Dirty Coms", "duration_ms": 2000, "images": [{"url": "episode.jpg"}]}`,
+			typ:      "episode",
+			name:     "112: Dirty Coms",
+			duration: 2 * time.Second,
+			image:    "episode.jpg",
+		},
+		{
+			json:     `{"type": "chapter", "id": "c", "uri": "spotify:episode:c", "name": "Book One: Dune", "duration_ms": 3000, "images": [], "audiobook": {"images": [{"url": "book.jpg"}]}}`,
+			typ:      "chapter",
+			name:     "Book One: Dune",
+			duration: 3 * time.Second,
+			image:    "book.jpg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			var item PlayableItem
+			if err := json.Unmarshal([]byte(tt.json), &item); err != nil {
+				t.Fatal(err)
+			}
+			if item.Type() != tt.typ {
+				t.Errorf("Expected type %s, got %s", tt.typ, item.Type())
+			}
+			if string(item.ID()) != tt.typ[:1] {
+				t.Errorf("Expected ID %s, got %s", tt.typ[:1], item.ID())
+			}
+			if item.Name() != tt.name {
+				t.Errorf("Expected name %s, got %s", tt.name, item.Name())
+			}
+			if item.Duration() != tt.duration {
+				t.Errorf("Expected duration %s, got %s", tt.duration, item.Duration())
+			}
+			if images := item.Images(); len(images) != 1 || images[0].URL != tt.image {
+				t.Errorf("Expected image %s, got %v", tt.image, images)
+			}
+
+			b, err := json.Marshal(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded PlayableItem
+			if err := json.Unmarshal(b, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if decoded.URI() != item.URI() || decoded.Type() != tt.typ {
+				t.Errorf("Expected %s after round trip, got %s", item.URI(), decoded.URI())
+			}
+		})
+	}
+}
+
+func TestPlayableItemEmpty(t *testing.T) {
+	var item PlayableItem
+	if err := json.Unmarshal([]byte("null"), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Type() != "" || item.URI() != "" || item.Duration() != 0 {
+		t.Error("Expected an empty item")
+	}
+	if b, err := json.Marshal(item); err != nil || string(b) != "null" {
+		t.Errorf("Expected null, got %s (%v)", b, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type": "ad"}`), &item); err == nil {
+		t.Error("Expected an error for an unrecognized type")
+	}
+}
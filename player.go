@@ -4,27 +4,67 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 )
 
+// DeviceType is the type of a [PlayerDevice].
+type DeviceType string
+
+// DeviceType values reported by the Web API.
+const (
+	DeviceTypeComputer    DeviceType = "Computer"
+	DeviceTypeTablet      DeviceType = "Tablet"
+	DeviceTypeSmartphone  DeviceType = "Smartphone"
+	DeviceTypeSpeaker     DeviceType = "Speaker"
+	DeviceTypeTV          DeviceType = "TV"
+	DeviceTypeAVR         DeviceType = "AVR"
+	DeviceTypeSTB         DeviceType = "STB"
+	DeviceTypeAudioDongle DeviceType = "AudioDongle"
+	DeviceTypeGameConsole DeviceType = "GameConsole"
+	DeviceTypeCastVideo   DeviceType = "CastVideo"
+	DeviceTypeCastAudio   DeviceType = "CastAudio"
+	DeviceTypeAutomobile  DeviceType = "Automobile"
+	DeviceTypeUnknown     DeviceType = "Unknown"
+)
+
+// RepeatState is the repeat mode of the user's playback.
+type RepeatState string
+
+// RepeatState values that can be passed to [Client.Repeat].
+const (
+	// RepeatOff turns repeat off.
+	RepeatOff RepeatState = "off"
+	// RepeatTrack repeats the current track.
+	RepeatTrack RepeatState = "track"
+	// RepeatContext repeats the current context, such as an album or a
+	// playlist.
+	RepeatContext RepeatState = "context"
+)
+
 // PlayerDevice contains information about a device that a user can play music on.
 type PlayerDevice struct {
 	// ID of the device. This may be empty.
 	ID ID `json:"id"`
 	// Active If this device is the currently active device.
 	Active bool `json:"is_active"`
+	// PrivateSession If this device is currently in a private session.
+	PrivateSession bool `json:"is_private_session"`
 	// Restricted Whether controlling this device is restricted. At present if
 	// this is "true" then no Web API commands will be accepted by this device.
 	Restricted bool `json:"is_restricted"`
 	// Name The name of the device.
 	Name string `json:"name"`
-	// Type of device, such as "Computer", "Smartphone" or "Speaker".
-	Type string `json:"type"`
+	// Type of device, such as [DeviceTypeComputer], [DeviceTypeSmartphone]
+	// or [DeviceTypeSpeaker].
+	Type DeviceType `json:"type"`
 	// Volume The current volume in percent.
 	Volume Numeric `json:"volume_percent"`
+	// SupportsVolume If this device can be used to set the volume.
+	SupportsVolume bool `json:"supports_volume"`
 }
 
 // PlayerState contains information about the current playback.
@@ -34,8 +74,33 @@ type PlayerState struct {
 	Device PlayerDevice `json:"device"`
 	// ShuffleState Shuffle is on or off
 	ShuffleState bool `json:"shuffle_state"`
+	// SmartShuffle If smart shuffle is on.  Smart shuffle adds recommended
+	// tracks to the playback; ShuffleState is also true when it is on.
+	SmartShuffle bool `json:"smart_shuffle"`
 	// RepeatState off, track, context
-	RepeatState string `json:"repeat_state"`
+	RepeatState RepeatState `json:"repeat_state"`
+}
+
+// Actions describes the playback actions that are currently available.
+type Actions struct {
+	// Disallows lists the actions that are not allowed.
+	Disallows Disallows `json:"disallows"`
+}
+
+// Disallows lists playback actions that are not allowed in the current
+// context.  A field is true if the action is disallowed; actions that are
+// allowed are omitted by the Web API and so are false.
+type Disallows struct {
+	InterruptingPlayback  bool `json:"interrupting_playback"`
+	Pausing               bool `json:"pausing"`
+	Resuming              bool `json:"resuming"`
+	Seeking               bool `json:"seeking"`
+	SkippingNext          bool `json:"skipping_next"`
+	SkippingPrev          bool `json:"skipping_prev"`
+	TogglingRepeatContext bool `json:"toggling_repeat_context"`
+	TogglingShuffle       bool `json:"toggling_shuffle"`
+	TogglingRepeatTrack   bool `json:"toggling_repeat_track"`
+	TransferringPlayback  bool `json:"transferring_playback"`
 }
 
 // PlaybackContext is the playback context.
@@ -60,8 +125,15 @@ type CurrentlyPlaying struct {
 	Progress Numeric `json:"progress_ms"`
 	// Playing If something is currently playing.
 	Playing bool `json:"is_playing"`
-	// The currently playing track. Can be null.
-	Item *FullTrack `json:"item"`
+	// The currently playing item. Can be null.  Episodes are only returned
+	// if [EpisodeAdditionalType] is requested with [AdditionalTypes].
+	Item *PlayableItem `json:"item"`
+	// CurrentlyPlayingType is the type of the currently playing item:
+	// "track", "episode", "ad" or "unknown".
+	CurrentlyPlayingType string `json:"currently_playing_type"`
+	// Actions that are currently available.  Controls for disallowed
+	// actions are rejected by the Web API.
+	Actions Actions `json:"actions"`
 }
 
 type RecentlyPlayedItem struct {
@@ -75,10 +147,15 @@ type RecentlyPlayedItem struct {
 	PlaybackContext PlaybackContext `json:"context"`
 }
 
+// RecentlyPlayedResult is a cursor-based paging object containing the
+// tracks a user has recently played, most recent first.
 type RecentlyPlayedResult struct {
+	cursorPage
 	Items []RecentlyPlayedItem `json:"items"`
 }
 
+func (r *RecentlyPlayedResult) items() []RecentlyPlayedItem { return r.Items }
+
 // PlaybackOffset can be specified either by track URI or Position. If the
 // Position field is set to a non-nil pointer, it will be taken into
 // consideration when specifying the playback offset. If the Position field is
@@ -132,20 +209,29 @@ type RecentlyPlayedOptions struct {
 	BeforeEpochMs int64
 }
 
+// Queue contains the item that is currently playing and the items in the
+// user's queue.
 type Queue struct {
-	CurrentlyPlaying FullTrack   `json:"currently_playing"`
-	Items            []FullTrack `json:"queue"`
+	// The currently playing item. Can be null.
+	CurrentlyPlaying *PlayableItem `json:"currently_playing"`
+	// The items in the queue.
+	Items []PlayableItem `json:"queue"`
 }
 
 // PlayerDevices information about available devices for the current user.
 //
 // Requires the [ScopeUserReadPlaybackState] scope in order to read information
 func (c *Client) PlayerDevices(ctx context.Context) ([]PlayerDevice, error) {
+	ctx = c.withOperation(ctx, "PlayerDevices")
+	return c.playerDevices(ctx)
+}
+
+func (c *Client) playerDevices(ctx context.Context, opts ...RequestOption) ([]PlayerDevice, error) {
 	var result struct {
 		PlayerDevices []PlayerDevice `json:"devices"`
 	}
 
-	err := c.get(ctx, c.baseURL+"me/player/devices", &result)
+	err := c.get(ctx, c.baseURL+"me/player/devices", &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +244,7 @@ func (c *Client) PlayerDevices(ctx context.Context) ([]PlayerDevice, error) {
 //
 // Supported options: [Market].
 func (c *Client) PlayerState(ctx context.Context, opts ...RequestOption) (*PlayerState, error) {
+	ctx = c.withOperation(ctx, "PlayerState")
 	spotifyURL := c.baseURL + "me/player"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -165,7 +252,7 @@ func (c *Client) PlayerState(ctx context.Context, opts ...RequestOption) (*Playe
 
 	var result PlayerState
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +268,7 @@ func (c *Client) PlayerState(ctx context.Context, opts ...RequestOption) (*Playe
 //
 // Supported options: [Market].
 func (c *Client) PlayerCurrentlyPlaying(ctx context.Context, opts ...RequestOption) (*CurrentlyPlaying, error) {
+	ctx = c.withOperation(ctx, "PlayerCurrentlyPlaying")
 	spotifyURL := c.baseURL + "me/player/currently-playing"
 
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
@@ -204,12 +292,27 @@ func (c *Client) PlayerCurrentlyPlaying(ctx context.Context, opts ...RequestOpti
 // PlayerRecentlyPlayed gets a list of recently-played tracks for the current
 // user. This call requires [ScopeUserReadRecentlyPlayed].
 func (c *Client) PlayerRecentlyPlayed(ctx context.Context) ([]RecentlyPlayedItem, error) {
+	ctx = c.withOperation(ctx, "PlayerRecentlyPlayed")
 	return c.PlayerRecentlyPlayedOpt(ctx, nil)
 }
 
 // PlayerRecentlyPlayedOpt is like [PlayerRecentlyPlayed], but it accepts
 // additional options for sorting and filtering the results.
 func (c *Client) PlayerRecentlyPlayedOpt(ctx context.Context, opt *RecentlyPlayedOptions) ([]RecentlyPlayedItem, error) {
+	ctx = c.withOperation(ctx, "PlayerRecentlyPlayedOpt")
+	result, err := c.PlayerRecentlyPlayedPage(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Items, nil
+}
+
+// PlayerRecentlyPlayedPage is like [PlayerRecentlyPlayedOpt], but it returns
+// the cursor-based paging object, so that older items can be fetched with
+// [Client.NextCursorPage] or iterated over with [Items].
+func (c *Client) PlayerRecentlyPlayedPage(ctx context.Context, opt *RecentlyPlayedOptions) (*RecentlyPlayedResult, error) {
+	ctx = c.withOperation(ctx, "PlayerRecentlyPlayedPage")
 	spotifyURL := c.baseURL + "me/player/recently-played"
 	if opt != nil {
 		v := url.Values{}
@@ -233,7 +336,7 @@ func (c *Client) PlayerRecentlyPlayedOpt(ctx context.Context, opt *RecentlyPlaye
 		return nil, err
 	}
 
-	return result.Items, nil
+	return &result, nil
 }
 
 // TransferPlayback transfers playback to a new device and determine if
@@ -246,6 +349,7 @@ func (c *Client) PlayerRecentlyPlayedOpt(ctx context.Context, opt *RecentlyPlaye
 //
 // Requires the [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) TransferPlayback(ctx context.Context, deviceID ID, play bool) error {
+	ctx = c.withOperation(ctx, "TransferPlayback")
 	reqData := struct {
 		DeviceID []ID `json:"device_ids"`
 		Play     bool `json:"play"`
@@ -272,11 +376,13 @@ func (c *Client) TransferPlayback(ctx context.Context, deviceID ID, play bool) e
 // Play Start a new context or resume current playback on the user's active
 // device. This call requires [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) Play(ctx context.Context) error {
+	ctx = c.withOperation(ctx, "Play")
 	return c.PlayOpt(ctx, nil)
 }
 
 // PlayOpt is like [Play] but with more options.
 func (c *Client) PlayOpt(ctx context.Context, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "PlayOpt")
 	spotifyURL := c.baseURL + "me/player/play"
 	buf := new(bytes.Buffer)
 
@@ -308,6 +414,7 @@ func (c *Client) PlayOpt(ctx context.Context, opt *PlayOptions) error {
 //
 // Requires the [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) Pause(ctx context.Context) error {
+	ctx = c.withOperation(ctx, "Pause")
 	return c.PauseOpt(ctx, nil)
 }
 
@@ -315,6 +422,7 @@ func (c *Client) Pause(ctx context.Context) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) PauseOpt(ctx context.Context, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "PauseOpt")
 	spotifyURL := c.baseURL + "me/player/pause"
 
 	if opt != nil {
@@ -339,6 +447,7 @@ func (c *Client) PauseOpt(ctx context.Context, opt *PlayOptions) error {
 // GetQueue gets the user's queue on the user's currently
 // active device. This call requires [ScopeUserReadPlaybackState]
 func (c *Client) GetQueue(ctx context.Context) (*Queue, error) {
+	ctx = c.withOperation(ctx, "GetQueue")
 	spotifyURL := c.baseURL + "me/player/queue"
 	v := url.Values{}
 
@@ -359,6 +468,7 @@ func (c *Client) GetQueue(ctx context.Context) (*Queue, error) {
 // active device. This call requires [ScopeUserModifyPlaybackState]
 // to modify the player state
 func (c *Client) QueueSong(ctx context.Context, trackID ID) error {
+	ctx = c.withOperation(ctx, "QueueSong")
 	return c.QueueSongOpt(ctx, trackID, nil)
 }
 
@@ -366,11 +476,25 @@ func (c *Client) QueueSong(ctx context.Context, trackID ID) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) QueueSongOpt(ctx context.Context, trackID ID, opt *PlayOptions) error {
-	uri := "spotify:track:" + trackID
+	ctx = c.withOperation(ctx, "QueueSongOpt")
+	return c.QueueItem(ctx, TrackURI(trackID), opt)
+}
+
+// QueueItem adds a track, episode or audiobook chapter, given its Spotify
+// URI, to the user's queue on the user's currently active device. This call
+// requires [ScopeUserModifyPlaybackState] to modify the player state.
+//
+// Only expects [PlayOptions.DeviceID], all other options will be ignored.
+func (c *Client) QueueItem(ctx context.Context, uri URI, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "QueueItem")
+	if !isPlayableURI(uri) {
+		return fmt.Errorf("spotify: %q is not the URI of a track, episode or chapter", uri)
+	}
+
 	spotifyURL := c.baseURL + "me/player/queue"
 	v := url.Values{}
 
-	v.Set("uri", uri.String())
+	v.Set("uri", string(uri))
 
 	if opt != nil {
 		if opt.DeviceID != nil {
@@ -397,6 +521,7 @@ func (c *Client) QueueSongOpt(ctx context.Context, trackID ID, opt *PlayOptions)
 // currently active device. This call requires [ScopeUserModifyPlaybackState]
 // in order to modify the player state.
 func (c *Client) Next(ctx context.Context) error {
+	ctx = c.withOperation(ctx, "Next")
 	return c.NextOpt(ctx, nil)
 }
 
@@ -404,6 +529,7 @@ func (c *Client) Next(ctx context.Context) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) NextOpt(ctx context.Context, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "NextOpt")
 	spotifyURL := c.baseURL + "me/player/next"
 
 	if opt != nil {
@@ -429,6 +555,7 @@ func (c *Client) NextOpt(ctx context.Context, opt *PlayOptions) error {
 // currently active device. This call requires [ScopeUserModifyPlaybackState]
 // in order to modify the player state
 func (c *Client) Previous(ctx context.Context) error {
+	ctx = c.withOperation(ctx, "Previous")
 	return c.PreviousOpt(ctx, nil)
 }
 
@@ -436,6 +563,7 @@ func (c *Client) Previous(ctx context.Context) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) PreviousOpt(ctx context.Context, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "PreviousOpt")
 	spotifyURL := c.baseURL + "me/player/previous"
 
 	if opt != nil {
@@ -465,6 +593,7 @@ func (c *Client) PreviousOpt(ctx context.Context, opt *PlayOptions) error {
 //
 // Requires the [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) Seek(ctx context.Context, position int) error {
+	ctx = c.withOperation(ctx, "Seek")
 	return c.SeekOpt(ctx, position, nil)
 }
 
@@ -472,6 +601,7 @@ func (c *Client) Seek(ctx context.Context, position int) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) SeekOpt(ctx context.Context, position int, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "SeekOpt")
 	return c.playerFuncWithOpt(
 		ctx,
 		"me/player/seek",
@@ -484,22 +614,24 @@ func (c *Client) SeekOpt(ctx context.Context, position int, opt *PlayOptions) er
 
 // Repeat Set the repeat mode for the user's playback.
 //
-// Options are track, context, and off.
+// Options are [RepeatTrack], [RepeatContext], and [RepeatOff].
 //
 // Requires the ScopeUserModifyPlaybackState in order to modify the player state.
-func (c *Client) Repeat(ctx context.Context, state string) error {
+func (c *Client) Repeat(ctx context.Context, state RepeatState) error {
+	ctx = c.withOperation(ctx, "Repeat")
 	return c.RepeatOpt(ctx, state, nil)
 }
 
 // RepeatOpt is like [Repeat] but with more options.
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
-func (c *Client) RepeatOpt(ctx context.Context, state string, opt *PlayOptions) error {
+func (c *Client) RepeatOpt(ctx context.Context, state RepeatState, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "RepeatOpt")
 	return c.playerFuncWithOpt(
 		ctx,
 		"me/player/repeat",
 		url.Values{
-			"state": []string{state},
+			"state": []string{string(state)},
 		},
 		opt,
 	)
@@ -511,6 +643,7 @@ func (c *Client) RepeatOpt(ctx context.Context, state string, opt *PlayOptions)
 //
 // Requires the [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) Volume(ctx context.Context, percent int) error {
+	ctx = c.withOperation(ctx, "Volume")
 	return c.VolumeOpt(ctx, percent, nil)
 }
 
@@ -518,6 +651,7 @@ func (c *Client) Volume(ctx context.Context, percent int) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) VolumeOpt(ctx context.Context, percent int, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "VolumeOpt")
 	return c.playerFuncWithOpt(
 		ctx,
 		"me/player/volume",
@@ -532,6 +666,7 @@ func (c *Client) VolumeOpt(ctx context.Context, percent int, opt *PlayOptions) e
 //
 // Requires the [ScopeUserModifyPlaybackState] in order to modify the player state.
 func (c *Client) Shuffle(ctx context.Context, shuffle bool) error {
+	ctx = c.withOperation(ctx, "Shuffle")
 	return c.ShuffleOpt(ctx, shuffle, nil)
 }
 
@@ -539,6 +674,7 @@ func (c *Client) Shuffle(ctx context.Context, shuffle bool) error {
 //
 // Only expects [PlayOptions.DeviceID], all other options will be ignored.
 func (c *Client) ShuffleOpt(ctx context.Context, shuffle bool, opt *PlayOptions) error {
+	ctx = c.withOperation(ctx, "ShuffleOpt")
 	return c.playerFuncWithOpt(
 		ctx,
 		"me/player/shuffle",
@@ -3,7 +3,9 @@ package spotify
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestTransferPlaybackDeviceUnavailable(t *testing.T) {
@@ -35,6 +37,26 @@ func TestVolume(t *testing.T) {
 	}
 }
 
+func TestRepeat(t *testing.T) {
+	client, server := testClientString(http.StatusNoContent, "", func(r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/me/player/repeat" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("state"); got != "context" {
+			t.Errorf("Expected state context, got %s", got)
+		}
+		if got := r.URL.Query().Get("device_id"); got != "device" {
+			t.Errorf("Expected device_id device, got %s", got)
+		}
+	})
+	defer server.Close()
+
+	device := ID("device")
+	if err := client.RepeatOpt(context.Background(), RepeatContext, &PlayOptions{DeviceID: &device}); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestQueue(t *testing.T) {
 	client, server := testClientString(http.StatusNoContent, "")
 	defer server.Close()
@@ -95,6 +117,19 @@ func TestPlayerState(t *testing.T) {
 	if state.Playing {
 		t.Error("Expected not to be playing")
 	}
+
+	if state.RepeatState != RepeatOff || !state.ShuffleState || !state.SmartShuffle {
+		t.Errorf("Unexpected repeat and shuffle state %s, %t, %t", state.RepeatState, state.ShuffleState, state.SmartShuffle)
+	}
+
+	if d := state.Device; d.Type != DeviceTypeSmartphone || !d.PrivateSession || d.SupportsVolume {
+		t.Errorf("Unexpected device %+v", d)
+	}
+
+	disallows := state.Actions.Disallows
+	if !disallows.Resuming || !disallows.SkippingPrev || disallows.Pausing || disallows.SkippingNext {
+		t.Errorf("Unexpected disallowed actions %+v", disallows)
+	}
 }
 
 func TestPlayerCurrentlyPlaying(t *testing.T) {
@@ -128,6 +163,36 @@ func TestPlayerCurrentlyPlaying(t *testing.T) {
 	}
 }
 
+func TestPlayerCurrentlyPlayingEpisode(t *testing.T) {
+	json := `{
+		"timestamp": 1771400000000,
+		"progress_ms": 60000,
+		"is_playing": true,
+		"currently_playing_type": "episode",
+		"item": {"type": "episode", "id": "2DSKnz9Hqm1tKimcXqcMJD", "name": "112: Dirty Coms", "duration_ms": 3600000}
+	}`
+	client, server := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if got := r.URL.Query().Get("additional_types"); got != "episode" {
+			t.Errorf("Expected additional_types episode, got %s", got)
+		}
+	})
+	defer server.Close()
+
+	state, err := client.PlayerCurrentlyPlaying(context.Background(), AdditionalTypes(EpisodeAdditionalType))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.CurrentlyPlayingType != "episode" {
+		t.Errorf("Expected currently playing type episode, got %s", state.CurrentlyPlayingType)
+	}
+	if state.Item == nil || state.Item.Episode == nil || state.Item.Track != nil {
+		t.Fatal("Expected item to be an episode")
+	}
+	if state.Item.Name() != "112: Dirty Coms" || state.Item.Duration() != time.Hour {
+		t.Errorf("Unexpected episode %s (%s)", state.Item.Name(), state.Item.Duration())
+	}
+}
+
 func TestPlayerRecentlyPlayed(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/player_recently_played.txt")
 	defer server.Close()
@@ -156,6 +221,42 @@ func TestPlayerRecentlyPlayed(t *testing.T) {
 	}
 }
 
+func TestPlayerRecentlyPlayedPage(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/player_recently_played.txt")
+	defer server.Close()
+
+	page, err := client.PlayerRecentlyPlayedPage(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Cursor.After != "1495915674720" || page.Cursor.Before != "1495842394544" {
+		t.Errorf("Unexpected cursors %+v", page.Cursor)
+	}
+	if int(page.Limit) != 20 || len(page.Items) != 20 {
+		t.Errorf("Expected 20 items, got %d (limit %d)", len(page.Items), page.Limit)
+	}
+
+	var requested string
+	nextClient, nextServer := testClientString(http.StatusOK, `{"items": [], "next": null, "cursors": null}`, func(r *http.Request) {
+		requested = r.URL.RawQuery
+	})
+	defer nextServer.Close()
+	page.Next = strings.Replace(page.Next, "https://api.spotify.com/v1", nextServer.URL, 1)
+
+	if err := nextClient.NextCursorPage(context.Background(), page); err != nil {
+		t.Fatal(err)
+	}
+	if requested != "before=1495842394544&type=track" {
+		t.Errorf("Expected request for items before the cursor, got %q", requested)
+	}
+	if len(page.Items) != 0 {
+		t.Errorf("Expected the page to be replaced, got %d items", len(page.Items))
+	}
+	if err := nextClient.NextCursorPage(context.Background(), page); err != ErrNoMorePages {
+		t.Errorf("Expected ErrNoMorePages, got %v", err)
+	}
+}
+
 func TestPlayArgsError(t *testing.T) {
 	json := `{
 		"error" : {
@@ -186,14 +287,15 @@ func TestGetQueue(t *testing.T) {
 		t.Errorf("Got %d playlists, expected 20\n", l)
 	}
 
-	p := queue.Items[0].SimpleTrack
-	if p.Name != "This Is the End (For You My Friend)" {
-		t.Error("Expected 'This Is the End (For You My Friend)', got", p.Name)
+	p := queue.Items[0].Track
+	if p == nil || p.Name != "This Is the End (For You My Friend)" {
+		t.Error("Expected 'This Is the End (For You My Friend)', got", queue.Items[0].Name())
 	}
 
-	p = queue.CurrentlyPlaying.SimpleTrack
-
-	if p.Name != "Know Your Enemy" {
-		t.Error("Expected 'Know Your Enemy', got", p.Name)
+	if queue.CurrentlyPlaying == nil || queue.CurrentlyPlaying.Track == nil {
+		t.Fatal("Expected the currently playing item to be a track")
+	}
+	if name := queue.CurrentlyPlaying.Name(); name != "Know Your Enemy" {
+		t.Error("Expected 'Know Your Enemy', got", name)
 	}
 }
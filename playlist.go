@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
@@ -61,6 +62,7 @@ type FullPlaylist struct {
 //
 // [list of playlists featured by Spotify]: https://developer.spotify.com/documentation/web-api/reference/get-featured-playlists
 func (c *Client) FeaturedPlaylists(ctx context.Context, opts ...RequestOption) (message string, playlists *SimplePlaylistPage, e error) {
+	ctx = c.withOperation(ctx, "FeaturedPlaylists")
 	spotifyURL := c.baseURL + "browse/featured-playlists"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -71,7 +73,7 @@ func (c *Client) FeaturedPlaylists(ctx context.Context, opts ...RequestOption) (
 		Message   string             `json:"message"`
 	}
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return "", nil, err
 	}
@@ -79,12 +81,44 @@ func (c *Client) FeaturedPlaylists(ctx context.Context, opts ...RequestOption) (
 	return result.Message, &result.Playlists, nil
 }
 
+// GetPlaylistsForUser gets a [list of the playlists] owned or followed by a
+// particular Spotify user.  Further pages can be fetched with
+// [Client.NextPage].
+//
+// Private playlists and collaborative playlists are only retrievable for the
+// current user.  In order to read private playlists, the user must have granted
+// the [ScopePlaylistReadPrivate] scope.  Note that this scope alone will not
+// return collaborative playlists, even though they are always private.  In
+// order to read collaborative playlists, the user must have granted the
+// [ScopePlaylistReadCollaborative] scope.
+//
+// Supported options: [Limit], [Offset].
+//
+// [list of the playlists]: https://developer.spotify.com/documentation/web-api/reference/get-list-users-playlists
+func (c *Client) GetPlaylistsForUser(ctx context.Context, userID ID, opts ...RequestOption) (*SimplePlaylistPage, error) {
+	ctx = c.withOperation(ctx, "GetPlaylistsForUser")
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists", c.baseURL, url.PathEscape(string(userID)))
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result SimplePlaylistPage
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // GetPlaylist [fetches a playlist] from spotify.
 //
 // Supported options: [Fields].
 //
 // [fetches a playlist]: https://developer.spotify.com/documentation/web-api/reference/get-playlist
 func (c *Client) GetPlaylist(ctx context.Context, playlistID ID, opts ...RequestOption) (*FullPlaylist, error) {
+	ctx = c.withOperation(ctx, "GetPlaylist")
 	spotifyURL := fmt.Sprintf("%splaylists/%s", c.baseURL, playlistID)
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -92,7 +126,7 @@ func (c *Client) GetPlaylist(ctx context.Context, playlistID ID, opts ...Request
 
 	var playlist FullPlaylist
 
-	err := c.get(ctx, spotifyURL, &playlist)
+	err := c.get(ctx, spotifyURL, &playlist, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +134,74 @@ func (c *Client) GetPlaylist(ctx context.Context, playlistID ID, opts ...Request
 	return &playlist, err
 }
 
+// FollowPlaylist [adds the current user as a follower] of the specified
+// playlist.  Any playlist can be followed, regardless of its private/public
+// status, as long as you know the playlist ID.
+//
+// If the public argument is true, then the playlist will be included in the
+// user's public playlists.  To be able to follow playlists privately, the user
+// must have granted the [ScopePlaylistModifyPrivate] scope.  The
+// [ScopePlaylistModifyPublic] scope is required to follow playlists publicly.
+//
+// [adds the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/follow-playlist
+func (c *Client) FollowPlaylist(ctx context.Context, playlistID ID, public bool) error {
+	ctx = c.withOperation(ctx, "FollowPlaylist")
+	spotifyURL := fmt.Sprintf("%splaylists/%s/followers", c.baseURL, playlistID)
+	body := struct {
+		Public bool `json:"public"`
+	}{public}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", spotifyURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.execute(req, nil)
+}
+
+// UnfollowPlaylist [removes the current user as a follower] of a playlist.
+// Unfollowing a publicly followed playlist requires [ScopePlaylistModifyPublic].
+// Unfollowing a privately followed playlist requires [ScopePlaylistModifyPrivate].
+//
+// [removes the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/unfollow-playlist
+func (c *Client) UnfollowPlaylist(ctx context.Context, playlistID ID) error {
+	ctx = c.withOperation(ctx, "UnfollowPlaylist")
+	spotifyURL := fmt.Sprintf("%splaylists/%s/followers", c.baseURL, playlistID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", spotifyURL, nil)
+	if err != nil {
+		return err
+	}
+	return c.execute(req, nil)
+}
+
+// UserFollowsPlaylist [checks if one or more Spotify users are following]
+// a Spotify playlist, given the playlist's ID.
+//
+// Checking if a user follows a playlist publicly doesn't require any scopes.
+// Checking if the user is privately following a playlist is only possible for the
+// current user when that user has granted access to the [ScopePlaylistReadPrivate] scope.
+//
+// The result is returned as a slice of bool values in the same order
+// in which the user IDs were specified.
+//
+// [checks if one or more Spotify users are following]: https://developer.spotify.com/documentation/web-api/reference/check-if-user-follows-playlist
+func (c *Client) UserFollowsPlaylist(ctx context.Context, playlistID ID, userIDs ...string) ([]bool, error) {
+	ctx = c.withOperation(ctx, "UserFollowsPlaylist")
+	spotifyURL := fmt.Sprintf("%splaylists/%s/followers/contains?ids=%s",
+		c.baseURL, playlistID, strings.Join(userIDs, ","))
+
+	var follows []bool
+	err := c.get(ctx, spotifyURL, &follows)
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
 // PlaylistItem contains info about an item in a playlist.
 type PlaylistItem struct {
 	// The date and time the track was added to the playlist.
@@ -113,43 +215,7 @@ type PlaylistItem struct {
 	// Whether this track is a local file or not.
 	IsLocal bool `json:"is_local"`
 	// Information about the item.
-	Item PlaylistItemTrack `json:"item"`
-}
-
-// PlaylistItemTrack is a union type for both tracks and episodes. If both
-// values are null, it's likely that the piece of content is not available in
-// the configured market.
-type PlaylistItemTrack struct {
-	Track   *FullTrack
-	Episode *EpisodePage
-}
-
-// UnmarshalJSON customises the unmarshalling based on the type flags set.
-func (t *PlaylistItemTrack) UnmarshalJSON(b []byte) error {
-	// Spotify API will return `track: null`` where the content is not available
-	// in the specified market. We should respect this and just pass the null
-	// up...
-	if bytes.Equal(b, []byte("null")) {
-		return nil
-	}
-
-	itemType := struct {
-		Type string `json:"type"`
-	}{}
-
-	err := json.Unmarshal(b, &itemType)
-	if err != nil {
-		return err
-	}
-
-	switch itemType.Type {
-	case "episode":
-		return json.Unmarshal(b, &t.Episode)
-	case "track":
-		return json.Unmarshal(b, &t.Track)
-	default:
-		return fmt.Errorf("unrecognized item type: %s", itemType.Type)
-	}
+	Item PlayableItem `json:"item"`
 }
 
 // PlaylistItemPage contains information about items in a playlist.
@@ -166,6 +232,7 @@ type PlaylistItemPage struct {
 // [gets full details of the items in a playlist]: https://developer.spotify.com/documentation/web-api/reference/get-playlists-tracks
 // [Spotify ID]: https://developer.spotify.com/documentation/web-api/#spotify-uris-and-ids
 func (c *Client) GetPlaylistItems(ctx context.Context, playlistID ID, opts ...RequestOption) (*PlaylistItemPage, error) {
+	ctx = c.withOperation(ctx, "GetPlaylistItems")
 	spotifyURL := fmt.Sprintf("%splaylists/%s/items", c.baseURL, playlistID)
 
 	// Add default as the first option so it gets override by url.Values#Set
@@ -177,7 +244,7 @@ func (c *Client) GetPlaylistItems(ctx context.Context, playlistID ID, opts ...Re
 
 	var result PlaylistItemPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +264,7 @@ func (c *Client) GetPlaylistItems(ctx context.Context, playlistID ID, opts ...Re
 //
 // [creates a playlist]: https://developer.spotify.com/documentation/web-api/reference/create-playlist
 func (c *Client) CreatePlaylist(ctx context.Context, playlistName, description string, public bool, collaborative bool) (*FullPlaylist, error) {
+	ctx = c.withOperation(ctx, "CreatePlaylist")
 	spotifyURL := fmt.Sprintf("%sme/playlists", c.baseURL)
 	body := struct {
 		Name          string `json:"name"`
@@ -235,6 +303,7 @@ func (c *Client) CreatePlaylist(ctx context.Context, playlistName, description s
 //
 // [changes the name of a playlist]: https://developer.spotify.com/documentation/web-api/reference/change-playlist-details
 func (c *Client) ChangePlaylistName(ctx context.Context, playlistID ID, newName string) error {
+	ctx = c.withOperation(ctx, "ChangePlaylistName")
 	return c.modifyPlaylist(ctx, playlistID, newName, "", nil)
 }
 
@@ -245,6 +314,7 @@ func (c *Client) ChangePlaylistName(ctx context.Context, playlistID ID, newName
 //
 // [modifies the public/private status of a playlist]: https://developer.spotify.com/documentation/web-api/reference/change-playlist-details
 func (c *Client) ChangePlaylistAccess(ctx context.Context, playlistID ID, public bool) error {
+	ctx = c.withOperation(ctx, "ChangePlaylistAccess")
 	return c.modifyPlaylist(ctx, playlistID, "", "", &public)
 }
 
@@ -255,6 +325,7 @@ func (c *Client) ChangePlaylistAccess(ctx context.Context, playlistID ID, public
 //
 // [modifies the description of a playlist]: https://developer.spotify.com/documentation/web-api/reference/change-playlist-details
 func (c *Client) ChangePlaylistDescription(ctx context.Context, playlistID ID, newDescription string) error {
+	ctx = c.withOperation(ctx, "ChangePlaylistDescription")
 	return c.modifyPlaylist(ctx, playlistID, "", newDescription, nil)
 }
 
@@ -263,6 +334,7 @@ func (c *Client) ChangePlaylistDescription(ctx context.Context, playlistID ID, n
 // or [ScopePlaylistModifyPrivate] scopes (depending on whether the playlist is currently
 // public or private).  The current user must own the playlist to modify it.
 func (c *Client) ChangePlaylistNameAndAccess(ctx context.Context, playlistID ID, newName string, public bool) error {
+	ctx = c.withOperation(ctx, "ChangePlaylistNameAndAccess")
 	return c.modifyPlaylist(ctx, playlistID, newName, "", &public)
 }
 
@@ -271,6 +343,7 @@ func (c *Client) ChangePlaylistNameAndAccess(ctx context.Context, playlistID ID,
 // the [ScopePlaylistModifyPublic] or [ScopePlaylistModifyPrivate] scopes (depending on whether the
 // playlist is currently public or private).  The current user must own the playlist in order to modify it.
 func (c *Client) ChangePlaylistNameAccessAndDescription(ctx context.Context, playlistID ID, newName, newDescription string, public bool) error {
+	ctx = c.withOperation(ctx, "ChangePlaylistNameAccessAndDescription")
 	return c.modifyPlaylist(ctx, playlistID, newName, newDescription, &public)
 }
 
@@ -305,9 +378,10 @@ func (c *Client) modifyPlaylist(ctx context.Context, playlistID ID, newName, new
 //
 // [adds one or more tracks to a user's playlist]: https://developer.spotify.com/documentation/web-api/reference/add-tracks-to-playlist
 func (c *Client) AddTracksToPlaylist(ctx context.Context, playlistID ID, trackIDs ...ID) (snapshotID string, err error) {
+	ctx = c.withOperation(ctx, "AddTracksToPlaylist")
 	uris := make([]string, len(trackIDs))
 	for i, id := range trackIDs {
-		uris[i] = fmt.Sprintf("spotify:track:%s", id)
+		uris[i] = string(TrackURI(id))
 	}
 	m := make(map[string]interface{})
 	m["uris"] = uris
@@ -346,12 +420,13 @@ func (c *Client) AddTracksToPlaylist(ctx context.Context, playlistID ID, trackID
 //
 // [removes one or more tracks from a user's playlist]: https://developer.spotify.com/documentation/web-api/reference/remove-tracks-playlist
 func (c *Client) RemoveTracksFromPlaylist(ctx context.Context, playlistID ID, trackIDs ...ID) (newSnapshotID string, err error) {
+	ctx = c.withOperation(ctx, "RemoveTracksFromPlaylist")
 	tracks := make([]struct {
 		URI string `json:"uri"`
 	}, len(trackIDs))
 
 	for i, u := range trackIDs {
-		tracks[i].URI = fmt.Sprintf("spotify:track:%s", u)
+		tracks[i].URI = string(TrackURI(u))
 	}
 	return c.removeTracksFromPlaylist(ctx, playlistID, tracks, "")
 }
@@ -368,7 +443,7 @@ type TrackToRemove struct {
 // track ID and playlist locations.
 func NewTrackToRemove(trackID string, positions []int) TrackToRemove {
 	return TrackToRemove{
-		URI:       fmt.Sprintf("spotify:track:%s", trackID),
+		URI:       string(TrackURI(ID(trackID))),
 		Positions: positions,
 	}
 }
@@ -391,6 +466,7 @@ func (c *Client) RemoveTracksFromPlaylistOpt(
 	tracks []TrackToRemove,
 	snapshotID string,
 ) (newSnapshotID string, err error) {
+	ctx = c.withOperation(ctx, "RemoveTracksFromPlaylistOpt")
 	return c.removeTracksFromPlaylist(ctx, playlistID, tracks, snapshotID)
 }
 
@@ -443,9 +519,10 @@ func (c *Client) removeTracksFromPlaylist(
 //
 // [replaces all of the tracks in a playlist]: https://developer.spotify.com/documentation/web-api/reference/reorder-or-replace-playlists-tracks
 func (c *Client) ReplacePlaylistTracks(ctx context.Context, playlistID ID, trackIDs ...ID) error {
+	ctx = c.withOperation(ctx, "ReplacePlaylistTracks")
 	trackURIs := make([]string, len(trackIDs))
 	for i, u := range trackIDs {
-		trackURIs[i] = fmt.Sprintf("spotify:track:%s", u)
+		trackURIs[i] = string(TrackURI(u))
 	}
 	spotifyURL := fmt.Sprintf("%splaylists/%s/items?uris=%s",
 		c.baseURL, playlistID, strings.Join(trackURIs, ","))
@@ -469,6 +546,7 @@ func (c *Client) ReplacePlaylistTracks(ctx context.Context, playlistID ID, track
 //
 // [replaces all the items in a playlist]: https://developer.spotify.com/documentation/web-api/reference/reorder-or-replace-playlists-tracks
 func (c *Client) ReplacePlaylistItems(ctx context.Context, playlistID ID, items ...URI) (string, error) {
+	ctx = c.withOperation(ctx, "ReplacePlaylistItems")
 	m := make(map[string]interface{})
 	m["uris"] = items
 
@@ -534,6 +612,7 @@ type PlaylistReorderOptions struct {
 // Reordering tracks in the user's private playlists (including collaborative playlists) requires
 // [ScopePlaylistModifyPrivate].
 func (c *Client) ReorderPlaylistTracks(ctx context.Context, playlistID ID, opt PlaylistReorderOptions) (snapshotID string, err error) {
+	ctx = c.withOperation(ctx, "ReorderPlaylistTracks")
 	spotifyURL := fmt.Sprintf("%splaylists/%s/items", c.baseURL, playlistID)
 	j, err := json.Marshal(opt)
 	if err != nil {
@@ -561,6 +640,7 @@ func (c *Client) ReorderPlaylistTracks(ctx context.Context, playlistID ID, opt P
 // and requires [ScopeImageUpload] as well as [ScopeModifyPlaylistPublic] or
 // [ScopeModifyPlaylistPrivate].
 func (c *Client) SetPlaylistImage(ctx context.Context, playlistID ID, img io.Reader) error {
+	ctx = c.withOperation(ctx, "SetPlaylistImage")
 	spotifyURL := fmt.Sprintf("%splaylists/%s/images", c.baseURL, playlistID)
 	// data flow:
 	// img (reader) -> copy into base64 encoder (writer) -> pipe (write end)
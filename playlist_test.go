@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -61,6 +62,36 @@ func TestFeaturedPlaylistsExpiredToken(t *testing.T) {
 	}
 }
 
+func TestGetPlaylistsForUser(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/playlists_for_user.txt", func(r *http.Request) {
+		if r.URL.Path != "/users/whizler/playlists" {
+			t.Errorf("Expected path /users/whizler/playlists, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("limit"); got != "20" {
+			t.Errorf("Expected limit 20, got %s", got)
+		}
+	})
+	defer server.Close()
+
+	playlists, err := client.GetPlaylistsForUser(context.Background(), "whizler", Limit(20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if playlists.Total != 7 {
+		t.Errorf("Expected 7 playlists, got %d", playlists.Total)
+	}
+	if len(playlists.Playlists) == 0 {
+		t.Fatal("Didn't get any playlists")
+	}
+	p := playlists.Playlists[0]
+	if p.ID != "5lH9NjOeJvctAO92ZrKQNB" || p.Name != "Top 40" {
+		t.Errorf("Unexpected first playlist %s (%s)", p.Name, p.ID)
+	}
+	if err := client.NextPage(context.Background(), playlists); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("Expected no more pages, got %v", err)
+	}
+}
+
 func TestGetPlaylist(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/get_playlist.txt")
 	defer server.Close()
@@ -205,6 +236,35 @@ func TestGetPlaylistItemsTracksAndEpisodes(t *testing.T) {
 	}
 }
 
+func TestGetPlaylistItemsChapters(t *testing.T) {
+	json := `{
+		"limit": 2,
+		"offset": 0,
+		"total": 2,
+		"items": [
+			{"added_at": "2026-03-01T10:00:00Z", "item": {"type": "chapter", "id": "2TbfJnrHSsbTCzyXaGL3bK", "name": "Book One: Dune", "audiobook": {"id": "7iHfbu1YPACw6oZPAFJtqe"}}},
+			{"added_at": "2026-03-01T10:01:00Z", "item": {"type": "track", "id": "5gIRMJ9WtO1fuQIVpazWrn", "name": "Typhoons"}}
+		]
+	}`
+	client, server := testClientString(http.StatusOK, json)
+	defer server.Close()
+
+	items, err := client.GetPlaylistItems(context.Background(), "playlistID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items.Items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(items.Items))
+	}
+	chapter := items.Items[0].Item.Chapter
+	if chapter == nil || chapter.Name != "Book One: Dune" || chapter.Audiobook.ID != "7iHfbu1YPACw6oZPAFJtqe" {
+		t.Errorf("Unexpected chapter %v", chapter)
+	}
+	if items.Items[0].Item.Track != nil || items.Items[1].Item.Track == nil {
+		t.Error("Expected only the second item to be a track")
+	}
+}
+
 func TestGetPlaylistItemsOverride(t *testing.T) {
 	var types string
 	client, server := testClientString(http.StatusForbidden, "", func(r *http.Request) {
@@ -659,3 +719,53 @@ func TestSetPlaylistImage(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFollowPlaylist(t *testing.T) {
+	client, server := testClientString(http.StatusOK, "", func(r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/playlists/2v3iNvBX8Ay1Gt2uXtUKUT/followers" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != `{"public":false}` {
+			t.Errorf("Unexpected body %s", body)
+		}
+	})
+	defer server.Close()
+
+	if err := client.FollowPlaylist(context.Background(), "2v3iNvBX8Ay1Gt2uXtUKUT", false); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnfollowPlaylist(t *testing.T) {
+	client, server := testClientString(http.StatusOK, "", func(r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/playlists/2v3iNvBX8Ay1Gt2uXtUKUT/followers" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	defer server.Close()
+
+	if err := client.UnfollowPlaylist(context.Background(), "2v3iNvBX8Ay1Gt2uXtUKUT"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserFollowsPlaylist(t *testing.T) {
+	client, server := testClientString(http.StatusOK, `[ true, false ]`, func(r *http.Request) {
+		if r.URL.Path != "/playlists/2v3iNvBX8Ay1Gt2uXtUKUT/followers/contains" || r.URL.Query().Get("ids") != "possan,elogain" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	follows, err := client.UserFollowsPlaylist(context.Background(), "2v3iNvBX8Ay1Gt2uXtUKUT", "possan", "elogain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(follows) != 2 || !follows[0] || follows[1] {
+		t.Errorf("Expected '[true, false]', got %#v\n", follows)
+	}
+}
@@ -0,0 +1,120 @@
+package spotify
+
+import (
+	"context"
+	"fmt"
+)
+
+// QueueOption is an option for [Client.QueueItems].
+type QueueOption func(*queueOptions)
+
+type queueOptions struct {
+	deviceID   *ID
+	skipQueued bool
+	progress   func(QueueProgress)
+}
+
+// QueueDevice adds the items to the queue of the device with the given ID
+// instead of the user's currently active device.
+func QueueDevice(id ID) QueueOption {
+	return func(o *queueOptions) {
+		o.deviceID = &id
+	}
+}
+
+// SkipQueued skips items that are already in the user's queue, or currently
+// playing, when the call starts, as well as repeated items in the list.  The
+// queue is read once with [Client.GetQueue], which requires the
+// [ScopeUserReadPlaybackState] scope.
+func SkipQueued() QueueOption {
+	return func(o *queueOptions) {
+		o.skipQueued = true
+	}
+}
+
+// OnQueueProgress registers a function that is called after each item has
+// been added to the queue or skipped.
+func OnQueueProgress(f func(QueueProgress)) QueueOption {
+	return func(o *queueOptions) {
+		o.progress = f
+	}
+}
+
+// QueueProgress reports the progress of [Client.QueueItems].
+type QueueProgress struct {
+	// URI of the item that was processed.
+	URI URI
+	// Index of the item in the list, starting at 0.
+	Index int
+	// Total number of items in the list.
+	Total int
+	// Skipped is true if the item was already in the queue and was not added
+	// again.
+	Skipped bool
+}
+
+// QueueItems adds tracks, episodes and audiobook chapters to the user's queue,
+// one at a time and in the order given.  It stops at the first item that
+// can't be added, so the items before it remain queued.  It returns the
+// number of items that were added.
+//
+// This call requires [ScopeUserModifyPlaybackState] to modify the player
+// state.
+func (c *Client) QueueItems(ctx context.Context, uris []URI, opts ...QueueOption) (int, error) {
+	ctx = c.withOperation(ctx, "QueueItems")
+	var o queueOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for _, uri := range uris {
+		if !isPlayableURI(uri) {
+			return 0, fmt.Errorf("spotify: %q is not the URI of a track, episode or chapter", uri)
+		}
+	}
+
+	var queued map[URI]bool
+	if o.skipQueued {
+		q, err := c.GetQueue(ctx)
+		if err != nil {
+			return 0, err
+		}
+		queued = map[URI]bool{}
+		if q.CurrentlyPlaying != nil {
+			queued[q.CurrentlyPlaying.URI()] = true
+		}
+		for _, item := range q.Items {
+			queued[item.URI()] = true
+		}
+	}
+
+	added := 0
+	for i, uri := range uris {
+		skipped := queued[uri]
+		if !skipped {
+			err := c.QueueItem(ctx, uri, &PlayOptions{DeviceID: o.deviceID})
+			if err != nil {
+				return added, fmt.Errorf("spotify: queueing item %d (%s): %w", i, uri, err)
+			}
+			added++
+			if queued != nil {
+				queued[uri] = true
+			}
+		}
+		if o.progress != nil {
+			o.progress(QueueProgress{URI: uri, Index: i, Total: len(uris), Skipped: skipped})
+		}
+	}
+	return added, nil
+}
+
+// isPlayableURI reports whether uri identifies an item that can be added to
+// the queue.
+func isPlayableURI(uri URI) bool {
+	switch uri.Type() {
+	case URITypeTrack, URITypeEpisode, URITypeChapter:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,96 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// queueTestClient serves a queue containing queued and accepts additions to
+// it, except for the URI fail.  It records the added items as uri@device.
+func queueTestClient(queued []URI, fail URI) (*Client, *httptest.Server, func() []string) {
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		if r.Method == http.MethodGet {
+			items := make([]string, len(queued))
+			for i, uri := range queued {
+				items[i] = `{"type": "` + string(uri.Type()) + `", "uri": "` + string(uri) + `"}`
+			}
+			_, _ = w.Write([]byte(`{"currently_playing": null, "queue": [` + strings.Join(items, ",") + `]}`))
+			return ""
+		}
+		uri := r.URL.Query().Get("uri")
+		if URI(uri) == fail {
+			http.Error(w, `{"error": {"status": 404, "message": "Not found"}}`, http.StatusNotFound)
+			return ""
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return uri + "@" + r.URL.Query().Get("device_id")
+	})
+}
+
+func TestQueueItem(t *testing.T) {
+	client, server, added := queueTestClient(nil, "")
+	defer server.Close()
+
+	if err := client.QueueItem(context.Background(), "spotify:episode:512ojhOuo1ktJprKbVcKyQ", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.QueueItem(context.Background(), "spotify:album:4aawyAB9vmqN3uQ7FjRGTy", nil); err == nil {
+		t.Error("Expected an error for an album URI")
+	}
+	if got := added(); len(got) != 1 || got[0] != "spotify:episode:512ojhOuo1ktJprKbVcKyQ@" {
+		t.Errorf("Unexpected requests %v", got)
+	}
+}
+
+func TestQueueItems(t *testing.T) {
+	client, server, added := queueTestClient([]URI{"spotify:track:b"}, "")
+	defer server.Close()
+
+	uris := []URI{"spotify:track:a", "spotify:track:b", "spotify:episode:c", "spotify:track:a"}
+	var progress []QueueProgress
+	n, err := client.QueueItems(context.Background(), uris,
+		SkipQueued(),
+		QueueDevice("speaker"),
+		OnQueueProgress(func(p QueueProgress) { progress = append(progress, p) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 items to be queued, got %d", n)
+	}
+	want := "spotify:track:a@speaker,spotify:episode:c@speaker"
+	if got := strings.Join(added(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	if len(progress) != 4 {
+		t.Fatalf("Expected 4 progress reports, got %d", len(progress))
+	}
+	for i, p := range progress {
+		if p.Index != i || p.Total != 4 || p.URI != uris[i] || p.Skipped != (i%2 == 1) {
+			t.Errorf("Unexpected progress %+v", p)
+		}
+	}
+}
+
+func TestQueueItemsStopsOnError(t *testing.T) {
+	client, server, added := queueTestClient(nil, "spotify:track:b")
+	defer server.Close()
+
+	n, err := client.QueueItems(context.Background(), []URI{"spotify:track:a", "spotify:track:b", "spotify:track:c"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if n != 1 || len(added()) != 1 {
+		t.Errorf("Expected only the first item to be queued, got %d", n)
+	}
+
+	n, err = client.QueueItems(context.Background(), []URI{"spotify:track:a", "spotify:artist:b"})
+	if err == nil || n != 0 || len(added()) != 1 {
+		t.Errorf("Expected invalid URIs to be rejected before queueing, got %d, %v", n, err)
+	}
+}
@@ -0,0 +1,150 @@
+package spotify
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that spaces out the requests sent by one or
+// more clients.  When Spotify answers a request with 429 Too Many Requests
+// and a Retry-After header, the limiter pauses every caller until the
+// requested time has passed, not just the one that was rate limited.
+//
+// A RateLimiter is safe for concurrent use, and may be shared between
+// clients that use the same application credentials.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu          sync.Mutex
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	stats       RateLimiterStats
+}
+
+// RateLimiterStats describes the activity of a [RateLimiter].
+type RateLimiterStats struct {
+	// Requests is the number of requests admitted by the limiter.
+	Requests int64
+	// Delayed is the number of requests that had to wait before they
+	// were admitted.
+	Delayed int64
+	// Queued is the number of requests currently waiting.
+	Queued int
+	// TotalWait is the time spent waiting by all requests.
+	TotalWait time.Duration
+	// MaxWait is the longest time a single request had to wait.
+	MaxWait time.Duration
+	// Pauses is the number of times the limiter was paused by a
+	// Retry-After header.
+	Pauses int64
+	// PausedUntil is the time until which requests are held back, or the
+	// zero time if the limiter has never been paused.
+	PausedUntil time.Time
+}
+
+// NewRateLimiter returns a [RateLimiter] that admits requestsPerSecond
+// requests per second on average, with bursts of up to burst requests.
+// A requestsPerSecond of zero or less doesn't limit the request rate, but
+// still honours Retry-After pauses.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = math.Inf(1)
+	}
+	b := float64(max(burst, 1))
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  b,
+		tokens: b,
+	}
+}
+
+// WithRateLimiter configures the Spotify API client to send every request,
+// including retries, through l.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(client *Client) {
+		client.limiter = l
+	}
+}
+
+// Wait blocks until the limiter admits a request, or until ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.refill(now)
+	// Reserve a token up front so that waiting callers are admitted in the
+	// order in which they arrived.
+	l.tokens--
+	var ready time.Time
+	if l.tokens < 0 {
+		ready = now.Add(time.Duration(-l.tokens / l.rate * float64(time.Second)))
+	}
+	if l.pausedUntil.After(ready) {
+		ready = l.pausedUntil
+	}
+	if !ready.After(now) {
+		l.stats.Requests++
+		l.mu.Unlock()
+		return nil
+	}
+	l.stats.Queued++
+	l.mu.Unlock()
+
+	for {
+		if !sleep(ctx, time.Until(ready)) {
+			l.mu.Lock()
+			l.stats.Queued--
+			l.tokens = min(l.tokens+1, l.burst)
+			l.mu.Unlock()
+			return ctx.Err()
+		}
+
+		l.mu.Lock()
+		// The limiter may have been paused again while we were waiting.
+		if !l.pausedUntil.After(time.Now()) {
+			waited := time.Since(now)
+			l.stats.Queued--
+			l.stats.Requests++
+			l.stats.Delayed++
+			l.stats.TotalWait += waited
+			l.stats.MaxWait = max(l.stats.MaxWait, waited)
+			l.mu.Unlock()
+			return nil
+		}
+		ready = l.pausedUntil
+		l.mu.Unlock()
+	}
+}
+
+// PauseUntil holds back all requests until t.  Calls that would end an
+// existing pause earlier are ignored.
+func (l *RateLimiter) PauseUntil(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+		l.stats.Pauses++
+	}
+}
+
+// Stats returns a snapshot of the limiter's activity.
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s := l.stats
+	s.PausedUntil = l.pausedUntil
+	return s
+}
+
+// refill adds the tokens accumulated since the last call.  l.mu must be held.
+func (l *RateLimiter) refill(now time.Time) {
+	if math.IsInf(l.rate, 1) {
+		l.tokens = l.burst
+	} else if !l.last.IsZero() {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	}
+	l.last = now
+}
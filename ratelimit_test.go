@@ -0,0 +1,117 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first request uses the burst, the next four wait 10ms each.
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("Expected requests to be spaced out, took %v", elapsed)
+	}
+
+	stats := l.Stats()
+	if stats.Requests != 5 {
+		t.Errorf("Expected 5 requests, got %d", stats.Requests)
+	}
+	if stats.Delayed == 0 || stats.TotalWait == 0 || stats.MaxWait == 0 {
+		t.Errorf("Expected delayed requests to be recorded, got %+v", stats)
+	}
+	if stats.Queued != 0 {
+		t.Errorf("Expected no queued requests, got %d", stats.Queued)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected requests not to be limited, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterPause(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	l.PauseUntil(time.Now().Add(50 * time.Millisecond))
+	// An earlier pause must not shorten the existing one.
+	l.PauseUntil(time.Now())
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+			if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+				t.Errorf("Expected caller to be paused, took %v", elapsed)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := l.Stats(); stats.Pauses != 1 || stats.Delayed != 3 {
+		t.Errorf("Expected 1 pause and 3 delayed requests, got %+v", stats)
+	}
+}
+
+func TestRateLimiterContextCancelled(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	l.PauseUntil(time.Now().Add(time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if stats := l.Stats(); stats.Queued != 0 || stats.Requests != 0 {
+		t.Errorf("Expected cancelled request not to be counted, got %+v", stats)
+	}
+}
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error": {"status": 429, "message": "API rate limit exceeded"}}`))
+	}))
+	defer server.Close()
+	client := New(http.DefaultClient, WithBaseURL(server.URL+"/"), WithRateLimiter(NewRateLimiter(0, 0)))
+
+	_, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+	var serr Error
+	if !errors.As(err, &serr) || serr.Status != http.StatusTooManyRequests {
+		t.Fatalf("Expected HTTP 429 error, got %v", err)
+	}
+
+	stats := client.limiter.Stats()
+	if stats.Pauses != 1 {
+		t.Errorf("Expected limiter to be paused once, got %d", stats.Pauses)
+	}
+	if until := time.Until(stats.PausedUntil); until < 25*time.Second || until > 30*time.Second {
+		t.Errorf("Expected limiter to be paused for 30s, got %v", until)
+	}
+}
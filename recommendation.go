@@ -79,6 +79,7 @@ func setTrackAttributesValues(trackAttributes *TrackAttributes, values url.Value
 //
 // [list of recommended tracks]: https://developer.spotify.com/documentation/web-api/reference/get-recommendations
 func (c *Client) GetRecommendations(ctx context.Context, seeds Seeds, trackAttributes *TrackAttributes, opts ...RequestOption) (*Recommendations, error) {
+	ctx = c.withOperation(ctx, "GetRecommendations")
 	v := processOptions(opts...).urlParams
 
 	if seeds.count() == 0 {
@@ -94,7 +95,7 @@ func (c *Client) GetRecommendations(ctx context.Context, seeds Seeds, trackAttri
 	spotifyURL := c.baseURL + "recommendations?" + v.Encode()
 
 	var recommendations Recommendations
-	err := c.get(ctx, spotifyURL, &recommendations)
+	err := c.get(ctx, spotifyURL, &recommendations, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +108,7 @@ func (c *Client) GetRecommendations(ctx context.Context, seeds Seeds, trackAttri
 //
 // [list of available genres]: https://developer.spotify.com/documentation/web-api/reference/get-recommendation-genres
 func (c *Client) GetAvailableGenreSeeds(ctx context.Context) ([]string, error) {
+	ctx = c.withOperation(ctx, "GetAvailableGenreSeeds")
 	spotifyURL := c.baseURL + "recommendations/available-genre-seeds"
 
 	genreSeeds := make(map[string][]string)
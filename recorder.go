@@ -0,0 +1,239 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+// SessionEntry records one play of a track, episode or chapter, as observed
+// by a [SessionRecorder].  Entries are written as one JSON object per line.
+type SessionEntry struct {
+	// Item that was played.
+	Item PlayableItem `json:"item"`
+	// StartedAt is when playback of the item started, as estimated from its
+	// progress when it was first seen.
+	StartedAt time.Time `json:"started_at"`
+	// EndedAt is when playback of the item ended.
+	EndedAt time.Time `json:"ended_at"`
+	// Listened is how long the item was actually playing, in milliseconds.
+	// Time spent paused is not counted, and neither are parts of the item
+	// that were skipped by seeking.
+	Listened Numeric `json:"listened_ms"`
+	// Skipped is true if playback moved on, or stopped, before the end of
+	// the item.
+	Skipped bool `json:"skipped"`
+	// SkippedAt is the position in the item, in milliseconds, at which it
+	// was skipped.
+	SkippedAt Numeric `json:"skipped_at_ms,omitempty"`
+	// Device the item was played on, when it was last seen.
+	Device PlayerDevice `json:"device"`
+	// Context the item was played from, such as an album or playlist.
+	Context PlaybackContext `json:"context"`
+}
+
+// ListenedDuration returns how long the item was actually playing.
+func (e *SessionEntry) ListenedDuration() time.Duration {
+	return time.Duration(e.Listened) * time.Millisecond
+}
+
+// RecorderOption is an option for [NewSessionRecorder].
+type RecorderOption func(*SessionRecorder)
+
+// RecordWatchOptions configures the [PlayerWatcher] that the recorder polls
+// with, for example with [WatchInterval].  [WatchCallback] is ignored.
+func RecordWatchOptions(opts ...WatchOption) RecorderOption {
+	return func(r *SessionRecorder) {
+		r.watchOpts = append(r.watchOpts, opts...)
+	}
+}
+
+// SkipThreshold sets how much of an item must remain when playback moves on
+// for it to count as skipped.  It allows for items ending between polls.
+// The default is 10 seconds.
+func SkipThreshold(d time.Duration) RecorderOption {
+	return func(r *SessionRecorder) {
+		r.skipThreshold = d
+	}
+}
+
+// SessionRecorder polls the user's playback state and writes a
+// [SessionEntry] for every item that is played, including whether it was
+// skipped.  Unlike [Client.PlayerRecentlyPlayed], it sees items that
+// Spotify doesn't count as played and is not limited to the last 50.
+//
+// Durations and positions are estimated from the polled state, so they are
+// accurate to about the polling interval.  Reading the playback state
+// requires the [ScopeUserReadPlaybackState] scope.
+type SessionRecorder struct {
+	watcher       *PlayerWatcher
+	enc           *json.Encoder
+	watchOpts     []WatchOption
+	skipThreshold time.Duration
+
+	current *listening
+	// lastAt is when the previous state was read, or zero.
+	lastAt time.Time
+}
+
+// listening is the item that is currently loaded.
+type listening struct {
+	entry    SessionEntry
+	listened time.Duration
+	// progress and playing are as of the last poll, at time at.
+	progress time.Duration
+	playing  bool
+	at       time.Time
+}
+
+// NewSessionRecorder creates a recorder that appends entries to log as
+// newline-delimited JSON.  Use [OpenSessionLog] to append to a file.
+func NewSessionRecorder(client *Client, log io.Writer, opts ...RecorderOption) *SessionRecorder {
+	r := &SessionRecorder{
+		enc:           json.NewEncoder(log),
+		skipThreshold: 10 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.watcher = NewPlayerWatcher(client, append(r.watchOpts, WatchCallback(func(PlayerEvent) {}))...)
+	return r
+}
+
+// Run records the user's playback until ctx is done or an entry can't be
+// written.  It must be called only once.  Errors reading the playback state
+// are ignored and polling continues.
+//
+// The item that is loaded when Run returns is not recorded, since it isn't
+// known yet how its playback ends.  Run returns the write error, or the
+// context's error.
+func (r *SessionRecorder) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var err error
+	r.watcher.onState = func(s *PlayerState, now time.Time) {
+		if err == nil {
+			if err = r.observe(s, now); err != nil {
+				cancel()
+			}
+		}
+	}
+	r.watcher.Run(ctx)
+	if err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// observe updates the recording with the state s, which was read at now,
+// and writes the entry for the previous item if it has finished.
+func (r *SessionRecorder) observe(s *PlayerState, now time.Time) error {
+	elapsed := now.Sub(r.lastAt)
+	if r.lastAt.IsZero() {
+		elapsed = 0
+	}
+	r.lastAt = now
+	progress := time.Duration(s.Progress) * time.Millisecond
+
+	if cur := r.current; cur != nil {
+		duration := cur.entry.Item.Duration()
+		sameItem := cur.entry.Item.URI() == itemURI(s.Item)
+		expected := cur.progress
+		if cur.playing {
+			expected += elapsed
+		}
+		// An item that restarts at about the time it should have ended is
+		// being repeated.
+		repeated := sameItem && cur.playing && expected >= duration && progress < cur.progress
+
+		if sameItem && !repeated {
+			if cur.playing {
+				cur.listened += elapsed
+			}
+			cur.update(s, progress, now)
+			return nil
+		}
+
+		end, endedAt := cur.progress, now
+		if cur.playing {
+			// Time since the last poll was spent on this item up to the
+			// point where the next one reached its current progress.
+			played := elapsed
+			if s.Item != nil && s.Playing {
+				played -= min(progress, played)
+			}
+			end = min(cur.progress+played, max(duration, cur.progress))
+			cur.listened += end - cur.progress
+			endedAt = cur.at.Add(end - cur.progress)
+		}
+		if err := r.finish(cur, end, endedAt); err != nil {
+			return err
+		}
+	}
+
+	r.current = nil
+	if s.Item == nil {
+		return nil
+	}
+	// Assume that the item has played from the start, but not for longer
+	// than since the last poll.
+	listened := progress
+	if elapsed > 0 {
+		listened = min(listened, elapsed)
+	}
+	r.current = &listening{
+		entry: SessionEntry{
+			Item:      *s.Item,
+			StartedAt: now.Add(-listened),
+		},
+		listened: listened,
+	}
+	r.current.update(s, progress, now)
+	return nil
+}
+
+func (l *listening) update(s *PlayerState, progress time.Duration, now time.Time) {
+	l.progress, l.playing, l.at = progress, s.Playing, now
+	l.entry.Device = s.Device
+	l.entry.Context = s.PlaybackContext
+}
+
+// finish writes the entry for l, which ended at position end at time
+// endedAt.
+func (r *SessionRecorder) finish(l *listening, end time.Duration, endedAt time.Time) error {
+	e := l.entry
+	e.EndedAt = endedAt
+	e.Listened = Numeric(l.listened.Milliseconds())
+	if e.Item.Duration()-end > r.skipThreshold {
+		e.Skipped = true
+		e.SkippedAt = Numeric(end.Milliseconds())
+	}
+	return r.enc.Encode(e)
+}
+
+// OpenSessionLog opens the file at path for appending session entries,
+// creating it if it doesn't exist.
+func OpenSessionLog(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+}
+
+// ReadSessionLog reads the entries written by a [SessionRecorder].
+func ReadSessionLog(r io.Reader) ([]SessionEntry, error) {
+	var entries []SessionEntry
+	dec := json.NewDecoder(r)
+	for {
+		var e SessionEntry
+		err := dec.Decode(&e)
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, e)
+	}
+}
@@ -0,0 +1,187 @@
+package spotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func recorderState(t *testing.T, track string, playing bool, progress int) *PlayerState {
+	t.Helper()
+	var s PlayerState
+	body := `{"device": {"id": "d1", "name": "Kitchen"}, "is_playing": false}`
+	if track != "" {
+		body = playerStateJSON(track, playing, progress, "d1", 50, false, "off", "spotify:album:a1")
+	}
+	if err := json.Unmarshal([]byte(body), &s); err != nil {
+		t.Fatal(err)
+	}
+	return &s
+}
+
+// recordStates feeds states, read at the given offsets from start, to a
+// recorder and returns the entries it wrote.
+func recordStates(t *testing.T, start time.Time, states []*PlayerState, offsets []time.Duration) []SessionEntry {
+	t.Helper()
+	var buf bytes.Buffer
+	r := NewSessionRecorder(nil, &buf)
+	for i, s := range states {
+		if err := r.observe(s, start.Add(offsets[i])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries, err := ReadSessionLog(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestSessionRecorderSkip(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := recordStates(t, start,
+		[]*PlayerState{
+			recorderState(t, "a", true, 0),
+			recorderState(t, "a", true, 5000),
+			recorderState(t, "b", true, 2000),
+		},
+		[]time.Duration{0, 5 * time.Second, 10 * time.Second},
+	)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	e := entries[0]
+	if e.Item.URI() != "spotify:track:a" {
+		t.Errorf("Expected track a, got %s", e.Item.URI())
+	}
+	if !e.Skipped || e.SkippedAt != 8000 {
+		t.Errorf("Expected skip at 8000ms, got %t at %d", e.Skipped, e.SkippedAt)
+	}
+	if e.ListenedDuration() != 8*time.Second {
+		t.Errorf("Expected 8s listened, got %s", e.ListenedDuration())
+	}
+	if !e.StartedAt.Equal(start) || !e.EndedAt.Equal(start.Add(8*time.Second)) {
+		t.Errorf("Unexpected times %s - %s", e.StartedAt, e.EndedAt)
+	}
+	if e.Device.ID != "d1" || e.Context.URI != "spotify:album:a1" {
+		t.Errorf("Unexpected device %s or context %s", e.Device.ID, e.Context.URI)
+	}
+}
+
+func TestSessionRecorderFinished(t *testing.T) {
+	start := time.Now()
+	entries := recordStates(t, start,
+		[]*PlayerState{
+			recorderState(t, "a", true, 190000),
+			recorderState(t, "a", true, 195000),
+			recorderState(t, "b", true, 2000),
+		},
+		[]time.Duration{0, 5 * time.Second, 12 * time.Second},
+	)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	e := entries[0]
+	if e.Skipped || e.SkippedAt != 0 {
+		t.Errorf("Expected no skip, got skip at %d", e.SkippedAt)
+	}
+	// 190s before the first poll, 5s between polls and the last 5s.
+	if e.Listened != 200000 {
+		t.Errorf("Expected 200000ms listened, got %d", e.Listened)
+	}
+}
+
+func TestSessionRecorderPaused(t *testing.T) {
+	start := time.Now()
+	entries := recordStates(t, start,
+		[]*PlayerState{
+			recorderState(t, "a", true, 10000),
+			recorderState(t, "a", false, 15000),
+			recorderState(t, "a", false, 15000),
+			recorderState(t, "b", true, 0),
+		},
+		[]time.Duration{0, 5 * time.Second, time.Minute, 70 * time.Second},
+	)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	e := entries[0]
+	if !e.Skipped || e.SkippedAt != 15000 {
+		t.Errorf("Expected skip at 15000ms, got %t at %d", e.Skipped, e.SkippedAt)
+	}
+	if e.Listened != 15000 {
+		t.Errorf("Expected 15000ms listened, got %d", e.Listened)
+	}
+	if !e.EndedAt.Equal(start.Add(70 * time.Second)) {
+		t.Errorf("Expected end at the last poll, got %s", e.EndedAt.Sub(start))
+	}
+}
+
+func TestSessionRecorderRepeatAndStop(t *testing.T) {
+	start := time.Now()
+	entries := recordStates(t, start,
+		[]*PlayerState{
+			recorderState(t, "a", true, 196000),
+			recorderState(t, "a", true, 3000),
+			recorderState(t, "a", true, 50000),
+			recorderState(t, "", false, 0),
+		},
+		[]time.Duration{0, 7 * time.Second, 54 * time.Second, 59 * time.Second},
+	)
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Skipped || entries[0].Listened != 200000 {
+		t.Errorf("Expected the first play to finish, got %+v", entries[0])
+	}
+	if !entries[1].Skipped || entries[1].SkippedAt != 55000 {
+		t.Errorf("Expected the repeat to stop at 55000ms, got %t at %d", entries[1].Skipped, entries[1].SkippedAt)
+	}
+	if entries[1].Listened != 55000 {
+		t.Errorf("Expected 55000ms listened, got %d", entries[1].Listened)
+	}
+}
+
+type chanWriter chan []byte
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	w <- bytes.Clone(p)
+	return len(p), nil
+}
+
+func TestSessionRecorderRun(t *testing.T) {
+	server := sequenceServer(t, []string{
+		playerStateJSON("a", true, 0, "d1", 50, false, "off", ""),
+		"",
+		playerStateJSON("b", true, 0, "d1", 50, false, "off", ""),
+	})
+	defer server.Close()
+	client := &Client{http: server.Client(), baseURL: server.URL + "/"}
+
+	log := make(chanWriter, 1)
+	r := NewSessionRecorder(client, log)
+	r.watcher.interval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx) }()
+
+	var entries []SessionEntry
+	select {
+	case line := <-log:
+		var err error
+		if entries, err = ReadSessionLog(bytes.NewReader(line)); err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for an entry")
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if len(entries) != 1 || entries[0].Item.URI() != "spotify:track:a" || !entries[0].Skipped {
+		t.Errorf("Expected a skipped entry for track a, got %+v", entries)
+	}
+}
@@ -10,6 +10,7 @@ type RequestOption func(*requestOptions)
 
 type requestOptions struct {
 	urlParams url.Values
+	cacheMode cacheMode
 }
 
 // Limit sets the number of entries that a request should return.
@@ -139,6 +140,24 @@ func AdditionalTypes(types ...AdditionalType) RequestOption {
 	}
 }
 
+// BypassCache makes a request skip the client's [Cache]: the response is
+// neither read from nor stored in the cache.  It applies to any call that
+// accepts request options and sends a GET request.
+func BypassCache() RequestOption {
+	return func(o *requestOptions) {
+		o.cacheMode = cacheBypass
+	}
+}
+
+// RevalidateCache makes a request revalidate a cached response with the
+// server, even if the response is still fresh.  It applies to any call that
+// accepts request options and sends a GET request.
+func RevalidateCache() RequestOption {
+	return func(o *requestOptions) {
+		o.cacheMode = cacheRevalidate
+	}
+}
+
 func processOptions(options ...RequestOption) requestOptions {
 	o := requestOptions{
 		urlParams: url.Values{},
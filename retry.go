@@ -0,0 +1,269 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client re-attempts requests that fail with
+// a transient error.  Use [DefaultRetryPolicy] as a starting point and
+// install the policy with [WithRetryPolicy].
+//
+// GET requests are retried whenever the response status is listed in
+// RetryStatuses, or the transport error is accepted by RetryErrors.  Requests
+// that modify state (PUT, POST, DELETE) are only retried when Spotify
+// rejected them with 429 Too Many Requests, or answered 202 Accepted where
+// that isn't a success, as any other failure may have happened after the
+// change was applied.  Set RetryMutating, or wrap the
+// context of an individual call with [AllowMutatingRetries], to retry them
+// on every retryable failure.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent,
+	// including the first attempt.  Values below 1 are treated as 1.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry.  The wait doubles
+	// with every further attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.  It does not apply
+	// to waits requested by the server with a Retry-After header.
+	MaxBackoff time.Duration
+	// Jitter is the fraction, from 0 to 1, of each backoff that is
+	// randomised so that concurrent callers don't retry in lockstep.
+	Jitter float64
+	// RetryStatuses lists the HTTP status codes that are retried.
+	RetryStatuses []int
+	// RetryErrors reports whether an error returned by the underlying
+	// http.Client is worth retrying.  If nil, transport errors are never
+	// retried.
+	RetryErrors func(error) bool
+	// RetryMutating allows PUT, POST and DELETE requests to be retried on
+	// any retryable failure, not just on 429 Too Many Requests and 202
+	// Accepted.
+	RetryMutating bool
+}
+
+// DefaultRetryPolicy returns a [RetryPolicy] that makes up to five attempts,
+// backing off exponentially from half a second to 30 seconds, and retries
+// rate limiting, 5xx gateway errors and [IsTransientError] failures.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryErrors: IsTransientError,
+	}
+}
+
+// WithRetryPolicy configures the Spotify API client to retry failed requests
+// according to p.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(client *Client) {
+		client.retry = &p
+	}
+}
+
+type mutatingRetriesKey struct{}
+
+// AllowMutatingRetries returns a copy of ctx that allows a PUT, POST or
+// DELETE request made with it to be retried on any failure accepted by the
+// client's [RetryPolicy].  Only use it for calls that are safe to apply
+// twice.
+func AllowMutatingRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mutatingRetriesKey{}, true)
+}
+
+// IsTransientError reports whether err is a network failure that is likely
+// to succeed if the request is sent again, such as a timeout or a connection
+// that was reset or closed by the server.  Context cancellation is never
+// considered transient.
+func IsTransientError(err error) bool {
+	if err == nil ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// maxAttempts returns the number of attempts allowed by the policy.
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns the wait before the given retry, where retry 1 is the
+// second attempt at a request.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < retry && d < math.MaxInt64/2; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 && d > 0 {
+		jitter := min(p.Jitter, 1)
+		d -= time.Duration(float64(d) * jitter * rand.Float64())
+	}
+	return d
+}
+
+// retryAfter returns the wait requested by the Retry-After header of resp.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 32)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// isMutating reports whether req may change state on the server.
+func isMutating(req *http.Request) bool {
+	return req.Method != http.MethodGet && req.Method != http.MethodHead
+}
+
+// canRetry reports whether the policy and the request allow another attempt
+// after the given one.
+func (c *Client) canRetry(req *http.Request, attempt int) bool {
+	if attempt >= c.retry.maxAttempts() {
+		return false
+	}
+	// The body of the request must be replayed on every attempt.
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// mutatingAllowed reports whether req may be retried after a failure that
+// could have occurred once the server already applied it.
+func (c *Client) mutatingAllowed(req *http.Request) bool {
+	if !isMutating(req) || c.retry.RetryMutating {
+		return true
+	}
+	allowed, _ := req.Context().Value(mutatingRetriesKey{}).(bool)
+	return allowed
+}
+
+// shouldRetryStatus reports whether the response status warrants another
+// attempt.  needsStatus lists codes that the caller treats as success.
+func (c *Client) shouldRetryStatus(req *http.Request, status int, needsStatus []int) bool {
+	if !isFailure(status, needsStatus) || !slices.Contains(c.retry.RetryStatuses, status) {
+		return false
+	}
+	return status == http.StatusTooManyRequests || status == http.StatusAccepted || c.mutatingAllowed(req)
+}
+
+// shouldRetryError reports whether a transport error warrants another attempt.
+func (c *Client) shouldRetryError(req *http.Request, err error) bool {
+	if req.Context().Err() != nil || c.retry.RetryErrors == nil {
+		return false
+	}
+	return c.retry.RetryErrors(err) && c.mutatingAllowed(req)
+}
+
+// do sends req, retrying it according to the client's [RetryPolicy].  Every
+// attempt waits for the client's [RateLimiter], if one is configured, and
+// runs through the client's [Middleware].
+// needsStatus lists HTTP status codes that the caller treats as success and
+// that must never be retried.  When the context is cancelled while waiting
+// for the next attempt, the last response is returned so that the caller
+// can report the original failure.
+func (c *Client) do(req *http.Request, needsStatus ...int) (*http.Response, error) {
+	if c.acceptLanguage != "" {
+		req.Header.Set("Accept-Language", c.acceptLanguage)
+	}
+
+	var operation string
+	if len(c.middleware) > 0 {
+		operation = requestOperation(req.Context())
+	}
+
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.send(&Call{
+			Operation: operation,
+			Request:   attemptReq,
+			Attempt:   attempt,
+		})
+		if err != nil {
+			if c.retry == nil || !c.canRetry(req, attempt) || !c.shouldRetryError(req, err) {
+				return nil, err
+			}
+			if !sleep(req.Context(), c.retry.backoff(attempt)) {
+				return nil, err
+			}
+			continue
+		}
+
+		wait, ok := retryAfter(resp)
+		if ok && resp.StatusCode == http.StatusTooManyRequests && c.limiter != nil {
+			c.limiter.PauseUntil(time.Now().Add(wait))
+		}
+		if c.retry == nil || !c.canRetry(req, attempt) || !c.shouldRetryStatus(req, resp.StatusCode, needsStatus) {
+			return resp, nil
+		}
+		if !ok {
+			wait = c.retry.backoff(attempt)
+		}
+		if !sleep(req.Context(), wait) {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+}
+
+// sleep waits for d to elapse.  It returns false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,243 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy returns a policy that retries quickly enough for tests.
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return &p
+}
+
+// retryTestClient returns a client whose server answers each request with
+// the next status in codes, repeating the last one once they run out.
+func retryTestClient(codes []int, validators ...func(*http.Request)) (*Client, *httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		for _, v := range validators {
+			v(r)
+		}
+		code := codes[min(n, len(codes))-1]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if code == http.StatusOK {
+			_, _ = io.WriteString(w, `{"name": "Timber"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"error": {"status": 503, "message": "try again"}}`)
+	}))
+	client := &Client{
+		http:    http.DefaultClient,
+		baseURL: server.URL + "/",
+		retry:   testRetryPolicy(),
+	}
+	return client, server, &calls
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	client, server, calls := retryTestClient([]int{
+		http.StatusServiceUnavailable,
+		http.StatusBadGateway,
+		http.StatusOK,
+	})
+	defer server.Close()
+
+	track, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if track.Name != "Timber" {
+		t.Errorf("Wanted track Timber, got %s", track.Name)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	client, server, calls := retryTestClient([]int{http.StatusServiceUnavailable})
+	defer server.Close()
+	client.retry.MaxAttempts = 3
+
+	_, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+	var serr Error
+	if !errors.As(err, &serr) || serr.Status != http.StatusServiceUnavailable {
+		t.Fatalf("Expected HTTP 503 error, got %v", err)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetryDisabled(t *testing.T) {
+	client, server, calls := retryTestClient([]int{http.StatusServiceUnavailable, http.StatusOK})
+	defer server.Close()
+	client.retry = nil
+
+	if _, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY"); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if n := atomic.LoadInt32(calls); n != 1 {
+		t.Errorf("Expected 1 attempt, got %d", n)
+	}
+}
+
+// recordBodies returns a validator that records the body of every request,
+// and a function returning the bodies recorded so far.
+func recordBodies() (func(*http.Request), func() []string) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	record := func(r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		bodies = append(bodies, string(b))
+	}
+	recorded := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(bodies)
+	}
+	return record, recorded
+}
+
+func TestRetryMutatingRequests(t *testing.T) {
+	t.Run("not retried by default", func(t *testing.T) {
+		client, server, calls := retryTestClient([]int{http.StatusServiceUnavailable, http.StatusOK})
+		defer server.Close()
+
+		if err := client.SaveToLibrary(context.Background(), "spotify:track:4iV5W9uYEdYUVa79Axb7Rh"); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if n := atomic.LoadInt32(calls); n != 1 {
+			t.Errorf("Expected 1 attempt, got %d", n)
+		}
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		record, recorded := recordBodies()
+		client, server, calls := retryTestClient([]int{http.StatusTooManyRequests, http.StatusOK}, record)
+		defer server.Close()
+
+		if err := client.SaveToLibrary(context.Background(), "spotify:track:4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+			t.Fatal(err)
+		}
+		if n := atomic.LoadInt32(calls); n != 2 {
+			t.Errorf("Expected 2 attempts, got %d", n)
+		}
+		bodies := recorded()
+		if len(bodies) != 2 || bodies[0] != bodies[1] || !strings.Contains(bodies[1], "4iV5W9uYEdYUVa79Axb7Rh") {
+			t.Errorf("Expected the body to be replayed, got %q", bodies)
+		}
+	})
+
+	t.Run("accepted with WithRetry", func(t *testing.T) {
+		client, server, calls := retryTestClient([]int{http.StatusAccepted, http.StatusOK})
+		defer server.Close()
+		WithRetry(true)(client)
+		client.retry.BaseBackoff = time.Millisecond
+
+		if err := client.SaveToLibrary(context.Background(), "spotify:track:4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+			t.Fatal(err)
+		}
+		if n := atomic.LoadInt32(calls); n != 2 {
+			t.Errorf("Expected 2 attempts, got %d", n)
+		}
+	})
+
+	t.Run("opted in per call", func(t *testing.T) {
+		record, recorded := recordBodies()
+		client, server, calls := retryTestClient([]int{http.StatusServiceUnavailable, http.StatusOK}, record)
+		defer server.Close()
+
+		ctx := AllowMutatingRetries(context.Background())
+		if err := client.SaveToLibrary(ctx, "spotify:track:4iV5W9uYEdYUVa79Axb7Rh"); err != nil {
+			t.Fatal(err)
+		}
+		if n := atomic.LoadInt32(calls); n != 2 {
+			t.Errorf("Expected 2 attempts, got %d", n)
+		}
+		if bodies := recorded(); len(bodies) != 2 || bodies[0] != bodies[1] {
+			t.Errorf("Expected the body to be replayed, got %q", bodies)
+		}
+	})
+}
+
+func TestRetryNetworkErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// Drop the connection without answering.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		_, _ = io.WriteString(w, `{"name": "Timber"}`)
+	}))
+	defer server.Close()
+	client := &Client{
+		http:    http.DefaultClient,
+		baseURL: server.URL + "/",
+		retry:   testRetryPolicy(),
+	}
+
+	track, err := client.GetTrack(context.Background(), "1zHlj4dQ8ZAtrayhuDDmkY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if track.Name != "Timber" {
+		t.Errorf("Wanted track Timber, got %s", track.Name)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Expected 2 attempts, got %d", n)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{
+		BaseBackoff: time.Second,
+		MaxBackoff:  5 * time.Second,
+	}
+	for retry, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.backoff(retry + 1); got != want {
+			t.Errorf("retry %d: expected %v, got %v", retry+1, want, got)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(1); got < 500*time.Millisecond || got > time.Second {
+			t.Fatalf("Jittered backoff %v out of range", got)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	if !IsTransientError(io.ErrUnexpectedEOF) {
+		t.Error("Expected unexpected EOF to be transient")
+	}
+	if IsTransientError(context.Canceled) {
+		t.Error("Expected context cancellation not to be transient")
+	}
+	if IsTransientError(errors.New("spotify: bad request")) {
+		t.Error("Expected arbitrary error not to be transient")
+	}
+}
@@ -0,0 +1,277 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Clock tells the time for a [Scheduler].  Tests can provide their own
+// Clock to run schedules without waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SchedulerOption is an option for [NewScheduler].
+type SchedulerOption func(*Scheduler)
+
+// SchedulerClock makes the scheduler use clock instead of the system clock.
+func SchedulerClock(clock Clock) SchedulerOption {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// SchedulerRetries sets how many times a player command that fails with a
+// transient error is attempted, and how long the scheduler waits between
+// attempts.  The default is 3 attempts, 2 seconds apart.
+func SchedulerRetries(attempts int, delay time.Duration) SchedulerOption {
+	return func(s *Scheduler) {
+		s.attempts = max(attempts, 1)
+		s.retryDelay = delay
+	}
+}
+
+// Scheduler runs timed player commands, such as alarms and sleep timers.
+//
+// Player commands that fail because of rate limiting, a server error, a
+// network failure or because no device is active yet are retried, as
+// configured by [SchedulerRetries].  All methods require the
+// [ScopeUserModifyPlaybackState] scope and block until they are done or
+// their context is cancelled.
+type Scheduler struct {
+	client     *Client
+	clock      Clock
+	attempts   int
+	retryDelay time.Duration
+}
+
+// NewScheduler creates a scheduler that controls playback with client.
+func NewScheduler(client *Client, opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		client:     client,
+		clock:      realClock{},
+		attempts:   3,
+		retryDelay: 2 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Fade describes a gradual change of volume.
+type Fade struct {
+	// From and To are the volumes, in percent, at the start and the end of
+	// the fade.
+	From, To int
+	// Duration of the fade.
+	Duration time.Duration
+	// Steps is the number of volume changes after the initial one.  Values
+	// below 1 are treated as 1.
+	Steps int
+}
+
+// PlayAt waits until the wall-clock time at and then starts playback with
+// opt, as [Client.PlayOpt] does.  If at has already passed, playback starts
+// immediately.
+func (s *Scheduler) PlayAt(ctx context.Context, at time.Time, opt *PlayOptions) error {
+	if err := s.sleep(ctx, at.Sub(s.clock.Now())); err != nil {
+		return err
+	}
+	return s.retry(ctx, func() error { return s.client.PlayOpt(ctx, opt) })
+}
+
+// Alarm waits until the wall-clock time at, starts playback with opt at the
+// volume fade.From and then fades the volume to fade.To.  If no device is
+// active yet, the volume is set as soon as playback has started.  On devices
+// without volume control, playback starts at the device's volume and isn't
+// faded.
+func (s *Scheduler) Alarm(ctx context.Context, at time.Time, opt *PlayOptions, fade Fade) error {
+	if err := s.sleep(ctx, at.Sub(s.clock.Now())); err != nil {
+		return err
+	}
+	// Lower the volume first so that playback doesn't start at full volume.
+	idle := false
+	err := s.retry(ctx, func() error {
+		err := s.volume(ctx, fade.From, opt)
+		if errors.Is(err, ErrNoActiveDevice) {
+			idle = true
+			return nil
+		}
+		return err
+	})
+	canFade := !isVolumeControlDisallowed(err)
+	if err != nil && canFade {
+		return err
+	}
+	if err := s.retry(ctx, func() error { return s.client.PlayOpt(ctx, opt) }); err != nil {
+		return err
+	}
+	if idle {
+		err := s.setVolume(ctx, fade.From, opt)
+		canFade = !isVolumeControlDisallowed(err)
+		if err != nil && canFade {
+			return err
+		}
+	}
+	if !canFade {
+		return nil
+	}
+	if err := s.fade(ctx, fade, opt); err != nil && !isVolumeControlDisallowed(err) {
+		return err
+	}
+	return nil
+}
+
+// FadeVolume sets the volume to fade.From and then changes it to fade.To
+// in fade.Steps equal steps spread over fade.Duration.
+//
+// Only expects [PlayOptions.DeviceID], all other options will be ignored.
+func (s *Scheduler) FadeVolume(ctx context.Context, fade Fade, opt *PlayOptions) error {
+	if err := s.setVolume(ctx, fade.From, opt); err != nil {
+		return err
+	}
+	return s.fade(ctx, fade, opt)
+}
+
+// fade changes the volume in steps, assuming that it's already at
+// fade.From.
+func (s *Scheduler) fade(ctx context.Context, fade Fade, opt *PlayOptions) error {
+	steps := max(fade.Steps, 1)
+	interval := fade.Duration / time.Duration(steps)
+	for i := 1; i <= steps; i++ {
+		if err := s.sleep(ctx, interval); err != nil {
+			return err
+		}
+		volume := fade.From + (fade.To-fade.From)*i/steps
+		if err := s.setVolume(ctx, volume, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SleepTimer waits for after, then fades the volume out over fadeOut in the
+// given number of steps and pauses playback.  The volume is then restored to
+// its level before the fade, so that playback resumes at the usual volume.
+// On devices without volume control, playback is paused without a fade.
+//
+// Reading the volume requires the [ScopeUserReadPlaybackState] scope.
+// Only expects [PlayOptions.DeviceID], all other options will be ignored.
+func (s *Scheduler) SleepTimer(ctx context.Context, after, fadeOut time.Duration, steps int, opt *PlayOptions) error {
+	if err := s.sleep(ctx, after); err != nil {
+		return err
+	}
+
+	var state *PlayerState
+	err := s.retry(ctx, func() (err error) {
+		state, err = s.client.PlayerState(ctx, BypassCache())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	volume, canFade := int(state.Device.Volume), state.Device.SupportsVolume
+	if opt != nil && opt.DeviceID != nil && *opt.DeviceID != state.Device.ID {
+		devices, err := s.client.playerDevices(ctx, BypassCache())
+		if err != nil {
+			return err
+		}
+		for _, d := range devices {
+			if d.ID == *opt.DeviceID {
+				volume, canFade = int(d.Volume), d.SupportsVolume
+			}
+		}
+	}
+
+	if canFade {
+		err := s.fade(ctx, Fade{From: volume, To: 0, Duration: fadeOut, Steps: steps}, opt)
+		if isVolumeControlDisallowed(err) {
+			canFade = false
+		} else if err != nil {
+			return err
+		}
+	}
+	err = s.retry(ctx, func() error { return s.client.PauseOpt(ctx, opt) })
+	if err != nil && !isAlreadyPaused(err) {
+		return err
+	}
+	if !canFade {
+		return nil
+	}
+	return s.setVolume(ctx, volume, opt)
+}
+
+func (s *Scheduler) setVolume(ctx context.Context, percent int, opt *PlayOptions) error {
+	return s.retry(ctx, func() error { return s.volume(ctx, percent, opt) })
+}
+
+// volume sets the volume once, without retrying.
+func (s *Scheduler) volume(ctx context.Context, percent int, opt *PlayOptions) error {
+	return s.client.VolumeOpt(ctx, min(max(percent, 0), 100), opt)
+}
+
+// sleep waits for d on the scheduler's clock, or until ctx is done.
+func (s *Scheduler) sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil || d <= 0 {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.clock.After(d):
+		return nil
+	}
+}
+
+// retry calls f until it succeeds, fails with an error that isn't
+// transient, or the attempts run out.
+func (s *Scheduler) retry(ctx context.Context, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !isTransientPlayerError(err) {
+			return err
+		}
+		if attempt >= s.attempts {
+			if attempt > 1 {
+				return fmt.Errorf("spotify: giving up after %d attempts: %w", attempt, err)
+			}
+			return err
+		}
+		if err := s.sleep(ctx, s.retryDelay); err != nil {
+			return err
+		}
+	}
+}
+
+// isTransientPlayerError reports whether a player command that failed with
+// err is likely to succeed if it's sent again a little later.
+func isTransientPlayerError(err error) bool {
+	return errors.Is(err, ErrRateLimited) ||
+		errors.Is(err, ErrServerError) ||
+		errors.Is(err, ErrNoActiveDevice) ||
+		IsTransientError(err)
+}
+
+func isAlreadyPaused(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.Reason == ReasonAlreadyPaused
+}
+
+// isVolumeControlDisallowed reports whether err is the error returned for
+// volume changes on devices that don't support them.
+func isVolumeControlDisallowed(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.Reason == ReasonVolumeControlDisallow
+}
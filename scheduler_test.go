@@ -0,0 +1,227 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a [Clock] whose time only advances when it is waited on.
+// Every wait returns immediately.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// playerTestClient serves state as the player state, records player
+// commands and fails the first failures of them with a server error.
+func playerTestClient(failures int, state string) (*Client, *httptest.Server, func() []string) {
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(state))
+			return ""
+		}
+		if failures > 0 {
+			failures--
+			http.Error(w, `{"error": {"status": 502, "message": "Bad gateway"}}`, http.StatusBadGateway)
+			return ""
+		}
+		body, _ := io.ReadAll(r.Body)
+		request := r.URL.Path
+		if v := r.URL.Query().Get("volume_percent"); v != "" {
+			request += "=" + v
+		}
+		if b := strings.TrimSpace(string(body)); b != "" {
+			request += " " + b
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return request
+	})
+}
+
+func TestSchedulerPlayAt(t *testing.T) {
+	client, server, requests := playerTestClient(2, "")
+	defer server.Close()
+
+	start := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	s := NewScheduler(client, SchedulerClock(clock), SchedulerRetries(3, time.Second))
+
+	uri := URI("spotify:playlist:37i9dQZF1DXcBWIGoYBM5M")
+	if err := s.PlayAt(context.Background(), start.Add(time.Hour), &PlayOptions{PlaybackContext: &uri}); err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(clock.waits); got != "[1h0m0s 1s 1s]" {
+		t.Errorf("Expected to wait an hour and retry twice, got %s", got)
+	}
+	want := `/me/player/play {"context_uri":"spotify:playlist:37i9dQZF1DXcBWIGoYBM5M"}`
+	if got := requests(); len(got) != 1 || got[0] != want {
+		t.Errorf("Expected %s, got %v", want, got)
+	}
+}
+
+func TestSchedulerGivesUp(t *testing.T) {
+	client, server, _ := playerTestClient(5, "")
+	defer server.Close()
+
+	s := NewScheduler(client, SchedulerClock(&fakeClock{}), SchedulerRetries(2, time.Second))
+	err := s.PlayAt(context.Background(), time.Time{}, nil)
+	if !errors.Is(err, ErrServerError) || !strings.Contains(err.Error(), "2 attempts") {
+		t.Errorf("Expected to give up after 2 attempts, got %v", err)
+	}
+}
+
+func TestSchedulerAlarm(t *testing.T) {
+	client, server, requests := playerTestClient(0, "")
+	defer server.Close()
+
+	clock := &fakeClock{}
+	s := NewScheduler(client, SchedulerClock(clock))
+	err := s.Alarm(context.Background(), time.Time{}.Add(time.Minute), nil, Fade{From: 10, To: 40, Duration: 90 * time.Second, Steps: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "/me/player/volume=10,/me/player/play,/me/player/volume=20,/me/player/volume=30,/me/player/volume=40"
+	if got := strings.Join(requests(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	if got := fmt.Sprint(clock.waits); got != "[1m0s 30s 30s 30s]" {
+		t.Errorf("Unexpected waits %s", got)
+	}
+}
+
+func TestSchedulerAlarmIdleDevice(t *testing.T) {
+	idle := true
+	client, server, requests := testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		request := r.URL.Path
+		if v := r.URL.Query().Get("volume_percent"); v != "" {
+			request += "=" + v
+		}
+		if idle && r.URL.Path == "/me/player/volume" {
+			http.Error(w, `{"error": {"status": 404, "message": "Player command failed: No active device found", "reason": "NO_ACTIVE_DEVICE"}}`, http.StatusNotFound)
+			return request + " failed"
+		}
+		idle = false
+		w.WriteHeader(http.StatusNoContent)
+		return request
+	})
+	defer server.Close()
+
+	s := NewScheduler(client, SchedulerClock(&fakeClock{}))
+	if err := s.Alarm(context.Background(), time.Time{}, nil, Fade{From: 10, To: 20, Duration: time.Minute, Steps: 1}); err != nil {
+		t.Fatal(err)
+	}
+	want := "/me/player/volume=10 failed,/me/player/play,/me/player/volume=10,/me/player/volume=20"
+	if got := strings.Join(requests(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestSchedulerSleepTimer(t *testing.T) {
+	client, server, requests := playerTestClient(0, `{"device": {"id": "speaker", "volume_percent": 60, "supports_volume": true}, "is_playing": true}`)
+	defer server.Close()
+
+	clock := &fakeClock{}
+	s := NewScheduler(client, SchedulerClock(clock))
+	if err := s.SleepTimer(context.Background(), 30*time.Minute, time.Minute, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "/me/player/volume=45,/me/player/volume=30,/me/player/volume=15,/me/player/volume=0,/me/player/pause,/me/player/volume=60"
+	if got := strings.Join(requests(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	if got := fmt.Sprint(clock.waits); got != "[30m0s 15s 15s 15s 15s]" {
+		t.Errorf("Unexpected waits %s", got)
+	}
+}
+
+// noVolumeTestClient serves the player state of test_data/player_state.txt,
+// whose device has no volume control, and rejects volume changes as
+// Spotify does.  It records player commands.
+func noVolumeTestClient(t *testing.T) (*Client, *httptest.Server, func() []string) {
+	state, err := os.ReadFile("test_data/player_state.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testClientRecording(func(w http.ResponseWriter, r *http.Request) string {
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write(state)
+		case r.URL.Path == "/me/player/volume":
+			http.Error(w, `{"error": {"status": 403, "message": "Cannot control device volume", "reason": "VOLUME_CONTROL_DISALLOW"}}`, http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+		return r.Method + " " + r.URL.Path
+	})
+}
+
+func TestSchedulerSleepTimerWithoutVolumeControl(t *testing.T) {
+	client, server, requests := noVolumeTestClient(t)
+	defer server.Close()
+
+	s := NewScheduler(client, SchedulerClock(&fakeClock{}))
+	if err := s.SleepTimer(context.Background(), time.Minute, time.Minute, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := "GET /me/player,PUT /me/player/pause"
+	if got := strings.Join(requests(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestSchedulerAlarmWithoutVolumeControl(t *testing.T) {
+	client, server, requests := noVolumeTestClient(t)
+	defer server.Close()
+
+	s := NewScheduler(client, SchedulerClock(&fakeClock{}))
+	if err := s.Alarm(context.Background(), time.Time{}, nil, Fade{From: 10, To: 40, Duration: time.Minute, Steps: 3}); err != nil {
+		t.Fatal(err)
+	}
+	want := "PUT /me/player/volume,PUT /me/player/play"
+	if got := strings.Join(requests(), ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestSchedulerCancel(t *testing.T) {
+	client, server, requests := playerTestClient(0, "")
+	defer server.Close()
+
+	// With the real clock, the scheduler waits until it is cancelled.
+	s := NewScheduler(client)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := s.PlayAt(ctx, time.Now().Add(time.Hour), nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if got := requests(); len(got) != 0 {
+		t.Errorf("Expected no requests, got %v", got)
+	}
+}
@@ -22,12 +22,13 @@ type SearchType int
 // that can be bitwise OR'd together to search for multiple types of content
 // simultaneously.
 const (
-	SearchTypeAlbum    SearchType = 1 << iota
-	SearchTypeArtist              = 1 << iota
-	SearchTypePlaylist            = 1 << iota
-	SearchTypeTrack               = 1 << iota
-	SearchTypeShow                = 1 << iota
-	SearchTypeEpisode             = 1 << iota
+	SearchTypeAlbum     SearchType = 1 << iota
+	SearchTypeArtist               = 1 << iota
+	SearchTypePlaylist             = 1 << iota
+	SearchTypeTrack                = 1 << iota
+	SearchTypeShow                 = 1 << iota
+	SearchTypeEpisode              = 1 << iota
+	SearchTypeAudiobook            = 1 << iota
 )
 
 func (st SearchType) encode() string {
@@ -50,18 +51,22 @@ func (st SearchType) encode() string {
 	if st&SearchTypeEpisode != 0 {
 		types = append(types, "episode")
 	}
+	if st&SearchTypeAudiobook != 0 {
+		types = append(types, "audiobook")
+	}
 	return strings.Join(types, ",")
 }
 
 // SearchResult contains the results of a call to [Search].
 // Fields that weren't searched for will be nil pointers.
 type SearchResult struct {
-	Artists   *FullArtistPage     `json:"artists"`
-	Albums    *SimpleAlbumPage    `json:"albums"`
-	Playlists *SimplePlaylistPage `json:"playlists"`
-	Tracks    *FullTrackPage      `json:"tracks"`
-	Shows     *SimpleShowPage     `json:"shows"`
-	Episodes  *SimpleEpisodePage  `json:"episodes"`
+	Artists    *FullArtistPage      `json:"artists"`
+	Albums     *SimpleAlbumPage     `json:"albums"`
+	Playlists  *SimplePlaylistPage  `json:"playlists"`
+	Tracks     *FullTrackPage       `json:"tracks"`
+	Shows      *SimpleShowPage      `json:"shows"`
+	Episodes   *SimpleEpisodePage   `json:"episodes"`
+	Audiobooks *SimpleAudiobookPage `json:"audiobooks"`
 }
 
 // Search gets [Spotify catalog information] about artists, albums, tracks,
@@ -123,6 +128,7 @@ type SearchResult struct {
 //
 // [Spotify catalog information]: https://developer.spotify.com/documentation/web-api/reference/search
 func (c *Client) Search(ctx context.Context, query string, t SearchType, opts ...RequestOption) (*SearchResult, error) {
+	ctx = c.withOperation(ctx, "Search")
 	v := processOptions(opts...).urlParams
 	v.Set("q", query)
 	v.Set("type", t.encode())
@@ -131,7 +137,7 @@ func (c *Client) Search(ctx context.Context, query string, t SearchType, opts ..
 
 	var result SearchResult
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +147,7 @@ func (c *Client) Search(ctx context.Context, query string, t SearchType, opts ..
 
 // NextArtistResults loads the next page of artists into the specified search result.
 func (c *Client) NextArtistResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextArtistResults")
 	if s.Artists == nil || s.Artists.Next == "" {
 		return ErrNoMorePages
 	}
@@ -149,6 +156,7 @@ func (c *Client) NextArtistResults(ctx context.Context, s *SearchResult) error {
 
 // PreviousArtistResults loads the previous page of artists into the specified search result.
 func (c *Client) PreviousArtistResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousArtistResults")
 	if s.Artists == nil || s.Artists.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -157,6 +165,7 @@ func (c *Client) PreviousArtistResults(ctx context.Context, s *SearchResult) err
 
 // NextAlbumResults loads the next page of albums into the specified search result.
 func (c *Client) NextAlbumResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextAlbumResults")
 	if s.Albums == nil || s.Albums.Next == "" {
 		return ErrNoMorePages
 	}
@@ -165,6 +174,7 @@ func (c *Client) NextAlbumResults(ctx context.Context, s *SearchResult) error {
 
 // PreviousAlbumResults loads the previous page of albums into the specified search result.
 func (c *Client) PreviousAlbumResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousAlbumResults")
 	if s.Albums == nil || s.Albums.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -173,6 +183,7 @@ func (c *Client) PreviousAlbumResults(ctx context.Context, s *SearchResult) erro
 
 // NextPlaylistResults loads the next page of playlists into the specified search result.
 func (c *Client) NextPlaylistResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextPlaylistResults")
 	if s.Playlists == nil || s.Playlists.Next == "" {
 		return ErrNoMorePages
 	}
@@ -181,6 +192,7 @@ func (c *Client) NextPlaylistResults(ctx context.Context, s *SearchResult) error
 
 // PreviousPlaylistResults loads the previous page of playlists into the specified search result.
 func (c *Client) PreviousPlaylistResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousPlaylistResults")
 	if s.Playlists == nil || s.Playlists.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -189,6 +201,7 @@ func (c *Client) PreviousPlaylistResults(ctx context.Context, s *SearchResult) e
 
 // PreviousTrackResults loads the previous page of tracks into the specified search result.
 func (c *Client) PreviousTrackResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousTrackResults")
 	if s.Tracks == nil || s.Tracks.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -197,6 +210,7 @@ func (c *Client) PreviousTrackResults(ctx context.Context, s *SearchResult) erro
 
 // NextTrackResults loads the next page of tracks into the specified search result.
 func (c *Client) NextTrackResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextTrackResults")
 	if s.Tracks == nil || s.Tracks.Next == "" {
 		return ErrNoMorePages
 	}
@@ -205,6 +219,7 @@ func (c *Client) NextTrackResults(ctx context.Context, s *SearchResult) error {
 
 // PreviousShowResults loads the previous page of shows into the specified search result.
 func (c *Client) PreviousShowResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousShowResults")
 	if s.Shows == nil || s.Shows.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -213,6 +228,7 @@ func (c *Client) PreviousShowResults(ctx context.Context, s *SearchResult) error
 
 // NextShowResults loads the next page of shows into the specified search result.
 func (c *Client) NextShowResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextShowResults")
 	if s.Shows == nil || s.Shows.Next == "" {
 		return ErrNoMorePages
 	}
@@ -221,6 +237,7 @@ func (c *Client) NextShowResults(ctx context.Context, s *SearchResult) error {
 
 // PreviousEpisodeResults loads the previous page of episodes into the specified search result.
 func (c *Client) PreviousEpisodeResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousEpisodeResults")
 	if s.Episodes == nil || s.Episodes.Previous == "" {
 		return ErrNoMorePages
 	}
@@ -229,8 +246,27 @@ func (c *Client) PreviousEpisodeResults(ctx context.Context, s *SearchResult) er
 
 // NextEpisodeResults loads the next page of episodes into the specified search result.
 func (c *Client) NextEpisodeResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextEpisodeResults")
 	if s.Episodes == nil || s.Episodes.Next == "" {
 		return ErrNoMorePages
 	}
 	return c.get(ctx, s.Episodes.Next, s)
 }
+
+// PreviousAudiobookResults loads the previous page of audiobooks into the specified search result.
+func (c *Client) PreviousAudiobookResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "PreviousAudiobookResults")
+	if s.Audiobooks == nil || s.Audiobooks.Previous == "" {
+		return ErrNoMorePages
+	}
+	return c.get(ctx, s.Audiobooks.Previous, s)
+}
+
+// NextAudiobookResults loads the next page of audiobooks into the specified search result.
+func (c *Client) NextAudiobookResults(ctx context.Context, s *SearchResult) error {
+	ctx = c.withOperation(ctx, "NextAudiobookResults")
+	if s.Audiobooks == nil || s.Audiobooks.Next == "" {
+		return ErrNoMorePages
+	}
+	return c.get(ctx, s.Audiobooks.Next, s)
+}
@@ -7,6 +7,15 @@ import (
 	"time"
 )
 
+// SavedEpisode is an episode saved in a user's library.
+type SavedEpisode struct {
+	// The date and time the episode was saved, represented as an ISO 8601 UTC
+	// timestamp with a zero offset (YYYY-MM-DDTHH:MM:SSZ). You can use
+	// [TimestampLayout] to convert this to a [time.Time].
+	AddedAt     string `json:"added_at"`
+	EpisodePage `json:"episode"`
+}
+
 type SavedShow struct {
 	// The date and time the show was saved, represented as an ISO 8601 UTC
 	// timestamp with a zero offset (YYYY-MM-DDTHH:MM:SSZ). You can use
@@ -172,6 +181,7 @@ func (e *EpisodePage) ReleaseDateTime() time.Time {
 //
 // [specific show]: https://developer.spotify.com/documentation/web-api/reference/get-a-show
 func (c *Client) GetShow(ctx context.Context, id ID, opts ...RequestOption) (*FullShow, error) {
+	ctx = c.withOperation(ctx, "GetShow")
 	spotifyURL := c.baseURL + "shows/" + string(id)
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -179,7 +189,7 @@ func (c *Client) GetShow(ctx context.Context, id ID, opts ...RequestOption) (*Fu
 
 	var result FullShow
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -187,20 +197,36 @@ func (c *Client) GetShow(ctx context.Context, id ID, opts ...RequestOption) (*Fu
 	return &result, nil
 }
 
+// GetShows retrieves information about [several shows], given their
+// [Spotify ID]s.  Any number of IDs may be given; they are requested 50 at a
+// time, concurrently.  Shows are returned in the order requested.  If a show is
+// not found, that position in the result slice will be nil.
+//
+// Supported options: [Market].
+//
+// [several shows]: https://developer.spotify.com/documentation/web-api/reference/get-multiple-shows
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetShows(ctx context.Context, ids []ID, opts ...RequestOption) ([]*SimpleShow, error) {
+	ctx = c.withOperation(ctx, "GetShows")
+	return getSeveral[SimpleShow](ctx, c, showBatch, ids, opts...)
+}
+
 // GetShowEpisodes retrieves paginated [episode information] about a specific show.
+// Further pages can be fetched with [Client.NextPage].
 //
 // Supported options: [Market], [Limit], [Offset].
 //
 // [episode information]: https://developer.spotify.com/documentation/web-api/reference/get-a-shows-episodes
-func (c *Client) GetShowEpisodes(ctx context.Context, id string, opts ...RequestOption) (*SimpleEpisodePage, error) {
-	spotifyURL := c.baseURL + "shows/" + id + "/episodes"
+func (c *Client) GetShowEpisodes(ctx context.Context, id ID, opts ...RequestOption) (*SimpleEpisodePage, error) {
+	ctx = c.withOperation(ctx, "GetShowEpisodes")
+	spotifyURL := c.baseURL + "shows/" + string(id) + "/episodes"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
 	}
 
 	var result SimpleEpisodePage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -210,19 +236,36 @@ func (c *Client) GetShowEpisodes(ctx context.Context, id string, opts ...Request
 
 // GetEpisode gets an [episode] from a show.
 //
+// Supported options: [Market].
+//
 // [episode]: https://developer.spotify.com/documentation/web-api/reference/get-an-episode
-func (c *Client) GetEpisode(ctx context.Context, id string, opts ...RequestOption) (*EpisodePage, error) {
-	spotifyURL := c.baseURL + "episodes/" + id
+func (c *Client) GetEpisode(ctx context.Context, id ID, opts ...RequestOption) (*EpisodePage, error) {
+	ctx = c.withOperation(ctx, "GetEpisode")
+	spotifyURL := c.baseURL + "episodes/" + string(id)
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
 	}
 
 	var result EpisodePage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.getItem(ctx, spotifyURL, episodeBatch, id, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
+
+// GetEpisodes gets [several episodes], given their [Spotify ID]s.  Any number
+// of IDs may be given; they are requested 50 at a time, concurrently.  Episodes
+// are returned in the order requested.  If an episode is not found, that
+// position in the result slice will be nil.
+//
+// Supported options: [Market].
+//
+// [several episodes]: https://developer.spotify.com/documentation/web-api/reference/get-multiple-episodes
+// [Spotify ID]: https://developer.spotify.com/documentation/web-api/concepts/spotify-uris-ids
+func (c *Client) GetEpisodes(ctx context.Context, ids []ID, opts ...RequestOption) ([]*EpisodePage, error) {
+	ctx = c.withOperation(ctx, "GetEpisodes")
+	return getSeveral[EpisodePage](ctx, c, episodeBatch, ids, opts...)
+}
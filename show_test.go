@@ -2,7 +2,9 @@ package spotify
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -45,15 +47,62 @@ func TestGetEpisode(t *testing.T) {
 	c, s := testClientFile(http.StatusOK, "test_data/get_episode.txt")
 	defer s.Close()
 
-	id := "2DSKnz9Hqm1tKimcXqcMJD"
+	id := ID("2DSKnz9Hqm1tKimcXqcMJD")
 	r, err := c.GetEpisode(context.Background(), id)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if r.ID.String() != id {
+	if r.ID != id {
 		t.Error("Invalid data:", r.ID)
 	}
 	if r.Type != "episode" {
 		t.Error("Invalid data:", r.ID)
 	}
 }
+
+func TestGetShows(t *testing.T) {
+	c, server, requests := batchTestClient()
+	defer server.Close()
+
+	ids := make([]ID, 120)
+	for i := range ids {
+		ids[i] = ID(fmt.Sprintf("show%d", i))
+	}
+	ids[60] = "missing"
+
+	shows, err := c.GetShows(context.Background(), ids, Market("SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shows) != len(ids) {
+		t.Fatalf("Expected %d shows, got %d", len(ids), len(shows))
+	}
+	if shows[60] != nil {
+		t.Error("Expected missing show to be nil")
+	}
+	if shows[119] == nil || shows[119].Name != "name of show119" {
+		t.Errorf("Unexpected last show %v", shows[119])
+	}
+	if got := len(requests()); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
+
+func TestGetEpisodes(t *testing.T) {
+	c, server, requests := batchTestClient()
+	defer server.Close()
+
+	episodes, err := c.GetEpisodes(context.Background(), []ID{"e1", "missing", "e2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(episodes) != 3 || episodes[1] != nil {
+		t.Fatalf("Unexpected episodes %v", episodes)
+	}
+	if episodes[2].ID != "e2" {
+		t.Errorf("Expected e2, got %s", episodes[2].ID)
+	}
+	if reqs := requests(); len(reqs) != 1 || !strings.HasPrefix(reqs[0], "/episodes?") {
+		t.Errorf("Expected a single request to /episodes, got %v", reqs)
+	}
+}
@@ -26,10 +26,6 @@ const (
 	// with a zero offset.  For example, PlaylistTrack's AddedAt field uses
 	// this format.
 	TimestampLayout = "2006-01-02T15:04:05Z"
-
-	// defaultRetryDurationS helps us fix an apparent server bug whereby we will
-	// be told to retry but not be given a wait-interval.
-	defaultRetryDuration = time.Second * 5
 )
 
 // Client is a client for working with the Spotify Web API.
@@ -38,16 +34,32 @@ type Client struct {
 	http    *http.Client
 	baseURL string
 
-	autoRetry      bool
+	retry          *RetryPolicy
+	limiter        *RateLimiter
+	middleware     []Middleware
+	cache          Cache
+	batcher        *batcher
 	acceptLanguage string
 }
 
 type ClientOption func(client *Client)
 
-// WithRetry configures the Spotify API client to automatically retry requests that fail due to rate limiting.
+// WithRetry configures the Spotify API client to automatically retry requests
+// that fail due to rate limiting or transient server errors, using
+// [DefaultRetryPolicy].  Like earlier versions, it also retries requests
+// that Spotify answers with 202 Accepted where that isn't a success.
+//
+// Deprecated: use [WithRetryPolicy], which allows the retry behaviour to be
+// tuned.
 func WithRetry(shouldRetry bool) ClientOption {
 	return func(client *Client) {
-		client.autoRetry = shouldRetry
+		if !shouldRetry {
+			client.retry = nil
+			return
+		}
+		p := DefaultRetryPolicy()
+		p.RetryStatuses = append(p.RetryStatuses, http.StatusAccepted)
+		client.retry = &p
 	}
 }
 
@@ -83,7 +95,8 @@ func New(httpClient *http.Client, opts ...ClientOption) *Client {
 }
 
 // URI identifies an artist, album, track, or category.  For example,
-// spotify:track:6rqhFgbbKwnb9MLmUQDhG6
+// spotify:track:6rqhFgbbKwnb9MLmUQDhG6.  Use [ParseURI] or [ParseLink] to
+// validate one, and [TrackURI] and similar functions to build one from an ID.
 type URI string
 
 // ID is a base-62 identifier for an artist, track, album, etc.
@@ -143,14 +156,28 @@ func (i Image) Download(dst io.Writer) error {
 }
 
 // Error represents an error returned by the Spotify Web API.
+//
+// Errors can be matched against the sentinel errors in this package with
+// [errors.Is], for example errors.Is(err, ErrNoActiveDevice).
 type Error struct {
 	// A short description of the error.
 	Message string `json:"message"`
 	// The HTTP status code.
 	Status int `json:"status"`
+	// Reason further classifies errors returned by the player endpoints,
+	// for example [ReasonNoActiveDevice].  It is empty for other endpoints.
+	Reason ErrorReason `json:"reason"`
 	// RetryAfter contains the time before which client should not retry a
 	// rate-limited request, calculated from the Retry-After header, when present.
 	RetryAfter time.Time `json:"-"`
+	// Method is the HTTP method of the failed request.
+	Method string `json:"-"`
+	// Endpoint is the path of the Web API endpoint that returned the error,
+	// for example "/v1/me/player/play".
+	Endpoint string `json:"-"`
+	// RequestID identifies the failed request in Spotify's logs, when the
+	// response included a request ID header.
+	RequestID string `json:"-"`
 }
 
 func (e Error) Error() string {
@@ -169,58 +196,56 @@ func decodeError(resp *http.Response) error {
 	if err != nil {
 		return err
 	}
-	if ctHeader := resp.Header.Get("Content-Type"); ctHeader == "" {
-		msg := string(responseBody)
-		if len(msg) == 0 {
-			msg = http.StatusText(resp.StatusCode)
-		}
 
-		return Error{
-			Message: msg,
-			Status:  resp.StatusCode,
-		}
+	e := Error{
+		Status:    resp.StatusCode,
+		RequestID: resp.Header.Get(requestIDHeader),
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Endpoint = resp.Request.URL.Path
+	}
+	if retryAfter, _ := strconv.Atoi(resp.Header.Get("Retry-After")); retryAfter != 0 {
+		e.RetryAfter = time.Now().Add(time.Duration(retryAfter) * time.Second)
 	}
 
-	if len(responseBody) == 0 {
-		return Error{
-			Message: "server response without body",
-			Status:  resp.StatusCode,
+	if ctHeader := resp.Header.Get("Content-Type"); ctHeader == "" {
+		e.Message = string(responseBody)
+		if len(e.Message) == 0 {
+			e.Message = http.StatusText(resp.StatusCode)
 		}
+		return e
 	}
 
-	buf := bytes.NewBuffer(responseBody)
+	if len(responseBody) == 0 {
+		e.Message = "server response without body"
+		return e
+	}
 
-	var e struct {
-		E Error `json:"error"`
+	var body struct {
+		E struct {
+			Message string      `json:"message"`
+			Reason  ErrorReason `json:"reason"`
+		} `json:"error"`
 	}
-	err = json.NewDecoder(buf).Decode(&e)
+	err = json.NewDecoder(bytes.NewBuffer(responseBody)).Decode(&body)
 	if err != nil {
-		return Error{
-			Message: fmt.Sprintf("failed to decode error response %q", responseBody),
-			Status:  resp.StatusCode,
-		}
+		e.Message = fmt.Sprintf("failed to decode error response %q", responseBody)
+		return e
 	}
 
-	e.E.Status = resp.StatusCode
-	if e.E.Message == "" {
+	e.Message = body.E.Message
+	e.Reason = body.E.Reason
+	if e.Message == "" {
 		// Some errors will result in there being a useful status-code but an
 		// empty message. An example of this is when we send some of the
 		// arguments directly in the HTTP query and the URL ends-up being too
 		// long.
 
-		e.E.Message = "server response without error description"
-	}
-	if retryAfter, _ := strconv.Atoi(resp.Header.Get("Retry-After")); retryAfter != 0 {
-		e.E.RetryAfter = time.Now().Add(time.Duration(retryAfter) * time.Second)
+		e.Message = "server response without error description"
 	}
 
-	return e.E
-}
-
-// shouldRetry determines whether the status code indicates that the
-// previous operation should be retried at a later time
-func shouldRetry(status int) bool {
-	return status == http.StatusAccepted || status == http.StatusTooManyRequests
+	return e
 }
 
 // isFailure determines whether the code indicates failure
@@ -237,90 +262,37 @@ func isFailure(code int, validCodes []int) bool {
 // status codes that will be treated as success. Note that we allow all 200s
 // even if there are additional success codes that represent success.
 func (c *Client) execute(req *http.Request, result interface{}, needsStatus ...int) error {
-	if c.acceptLanguage != "" {
-		req.Header.Set("Accept-Language", c.acceptLanguage)
-	}
-	for {
-		resp, err := c.http.Do(req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
-
-		if c.autoRetry &&
-			isFailure(resp.StatusCode, needsStatus) &&
-			shouldRetry(resp.StatusCode) {
-			select {
-			case <-req.Context().Done():
-				// If the context is cancelled, return the original error
-			case <-time.After(retryDuration(resp)):
-				continue
-			}
-		}
-		if resp.StatusCode == http.StatusNoContent {
-			return nil
-		}
-		if (resp.StatusCode >= 300 ||
-			resp.StatusCode < 200) &&
-			isFailure(resp.StatusCode, needsStatus) {
-			return decodeError(resp)
-		}
-
-		if result != nil {
-			if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-				return err
-			}
-		}
-		break
+	resp, err := c.do(req, needsStatus...)
+	if err != nil {
+		return err
 	}
-	return nil
-}
+	defer resp.Body.Close()
 
-func retryDuration(resp *http.Response) time.Duration {
-	raw := resp.Header.Get("Retry-After")
-	if raw == "" {
-		return defaultRetryDuration
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
 	}
-	seconds, err := strconv.ParseInt(raw, 10, 32)
-	if err != nil {
-		return defaultRetryDuration
+	if (resp.StatusCode >= 300 ||
+		resp.StatusCode < 200) &&
+		isFailure(resp.StatusCode, needsStatus) {
+		return decodeError(resp)
 	}
-	return time.Duration(seconds) * time.Second
-}
 
-func (c *Client) get(ctx context.Context, url string, result interface{}) error {
-	for {
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if c.acceptLanguage != "" {
-			req.Header.Set("Accept-Language", c.acceptLanguage)
-		}
-		if err != nil {
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
 			return err
 		}
-		resp, err := c.http.Do(req)
-		if err != nil {
-			return err
-		}
-
-		defer resp.Body.Close()
-
-		if resp.StatusCode == http.StatusTooManyRequests && c.autoRetry {
-			select {
-			case <-ctx.Done():
-				// If the context is cancelled, return the original error
-			case <-time.After(retryDuration(resp)):
-				continue
-			}
-		}
-		if resp.StatusCode == http.StatusNoContent {
-			return nil
-		}
-		if resp.StatusCode != http.StatusOK {
-			return decodeError(resp)
-		}
+	}
+	return nil
+}
 
-		return json.NewDecoder(resp.Body).Decode(result)
+// get sends a GET request to url and decodes the response into result.
+// Cache related options in opts are honoured when the client has a [Cache].
+func (c *Client) get(ctx context.Context, url string, result interface{}, opts ...RequestOption) error {
+	body, err := c.fetch(ctx, url, processOptions(opts...))
+	if err != nil || body == nil {
+		return err
 	}
+	return json.NewDecoder(bytes.NewReader(body)).Decode(result)
 }
 
 // Token gets the client's current token.
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -50,6 +51,32 @@ func testClientFile(code int, filename string, validators ...func(*http.Request)
 	return testClient(code, f, validators...)
 }
 
+// Returns a client whose requests are handled by handler, one at a time.
+// handler returns a description of each request to record, or "" to record
+// nothing; the recorded descriptions are returned by the returned function.
+func testClientRecording(handler func(w http.ResponseWriter, r *http.Request) string) (*Client, *httptest.Server, func() []string) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if request := handler(w, r); request != "" {
+			requests = append(requests, request)
+		}
+	}))
+	client := &Client{
+		http:    http.DefaultClient,
+		baseURL: server.URL + "/",
+	}
+	return client, server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), requests...)
+	}
+}
+
 func TestClient_Token(t *testing.T) {
 	// oauth setup for valid test token
 	config := oauth2.Config{
@@ -87,6 +87,7 @@ func (t *SimpleTrack) TimeDuration() time.Duration {
 // [single track]: https://developer.spotify.com/documentation/web-api/reference/get-track
 // [Spotify ID]: https://developer.spotify.com/documentation/web-api/#spotify-uris-and-ids
 func (c *Client) GetTrack(ctx context.Context, id ID, opts ...RequestOption) (*FullTrack, error) {
+	ctx = c.withOperation(ctx, "GetTrack")
 	spotifyURL := c.baseURL + "tracks/" + string(id)
 
 	var t FullTrack
@@ -95,10 +96,25 @@ func (c *Client) GetTrack(ctx context.Context, id ID, opts ...RequestOption) (*F
 		spotifyURL += "?" + params
 	}
 
-	err := c.get(ctx, spotifyURL, &t)
+	err := c.getItem(ctx, spotifyURL, trackBatch, id, &t, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &t, nil
 }
+
+// GetTracks gets Spotify catalog information for [multiple tracks] based on
+// their [Spotify IDs].  Any number of IDs may be given; they are requested 50
+// at a time, concurrently.  Tracks are returned in the order requested.  If a
+// track is not found, that position in the result will be nil.  Duplicate IDs
+// result in duplicate tracks in the result.
+//
+// Supported options: [Market].
+//
+// [multiple tracks]: https://developer.spotify.com/documentation/web-api/reference/get-several-tracks
+// [Spotify IDs]: https://developer.spotify.com/documentation/web-api/#spotify-uris-and-ids
+func (c *Client) GetTracks(ctx context.Context, ids []ID, opts ...RequestOption) ([]*FullTrack, error) {
+	ctx = c.withOperation(ctx, "GetTracks")
+	return getSeveral[FullTrack](ctx, c, trackBatch, ids, opts...)
+}
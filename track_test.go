@@ -2,6 +2,7 @@ package spotify
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 )
@@ -33,3 +34,62 @@ func TestFindTrackWithFloats(t *testing.T) {
 		t.Errorf("Wanted track Timer, got %s\n", track.Name)
 	}
 }
+
+func TestFindTracksNotFound(t *testing.T) {
+	client, server := testClientFile(http.StatusOK, "test_data/find_tracks_notfound.txt", func(r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "0eGsygTp906u18L0Oimnem,1lDWb6b6ieDQ2xT7ewTC3G" {
+			t.Errorf("Unexpected ids %q", ids)
+		}
+		if market := r.URL.Query().Get("market"); market != "SE" {
+			t.Errorf("Expected market SE, got %q", market)
+		}
+	})
+	defer server.Close()
+
+	tracks, err := client.GetTracks(context.Background(), []ID{"0eGsygTp906u18L0Oimnem", "1lDWb6b6ieDQ2xT7ewTC3G"}, Market("SE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tracks) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(tracks))
+	}
+	if tracks[0] == nil || tracks[0].Name != "Mr. Brightside" {
+		t.Errorf("Expected Mr. Brightside, got %v", tracks[0])
+	}
+	if tracks[1] != nil {
+		t.Errorf("Expected nil for the unknown track, got %s", tracks[1].Name)
+	}
+}
+
+func TestGetTracksChunks(t *testing.T) {
+	client, server, requests := batchTestClient()
+	defer server.Close()
+
+	ids := make([]ID, 120)
+	for i := range ids {
+		ids[i] = ID(fmt.Sprintf("track%d", i))
+	}
+	ids[70] = "missing"
+
+	tracks, err := client.GetTracks(context.Background(), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tracks) != len(ids) {
+		t.Fatalf("Expected %d results, got %d", len(ids), len(tracks))
+	}
+	for i, track := range tracks {
+		if i == 70 {
+			if track != nil {
+				t.Errorf("Expected nil for the unknown track, got %s", track.ID)
+			}
+			continue
+		}
+		if track == nil || track.ID != ids[i] {
+			t.Errorf("Expected track %s at position %d, got %v", ids[i], i, track)
+		}
+	}
+	if got := len(requests()); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
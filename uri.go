@@ -0,0 +1,197 @@
+package spotify
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidURI is the error returned when a string can't be parsed as a
+// Spotify URI or link.
+var ErrInvalidURI = errors.New("spotify: invalid URI")
+
+// URIType is the kind of item that a [URI] identifies.
+type URIType string
+
+// URIType values.
+const (
+	URITypeTrack     URIType = "track"
+	URITypeAlbum     URIType = "album"
+	URITypeArtist    URIType = "artist"
+	URITypePlaylist  URIType = "playlist"
+	URITypeShow      URIType = "show"
+	URITypeEpisode   URIType = "episode"
+	URITypeAudiobook URIType = "audiobook"
+	URITypeChapter   URIType = "chapter"
+	URITypeUser      URIType = "user"
+)
+
+var uriTypes = map[URIType]bool{
+	URITypeTrack:     true,
+	URITypeAlbum:     true,
+	URITypeArtist:    true,
+	URITypePlaylist:  true,
+	URITypeShow:      true,
+	URITypeEpisode:   true,
+	URITypeAudiobook: true,
+	URITypeChapter:   true,
+	URITypeUser:      true,
+}
+
+// ParsedURI is the type and ID of an item, as returned by [ParseURI] and
+// [ParseLink].
+type ParsedURI struct {
+	Type URIType
+	ID   ID
+}
+
+// URI returns the Spotify URI of the item, such as
+// spotify:track:6rqhFgbbKwnb9MLmUQDhG6.
+func (p ParsedURI) URI() URI {
+	id := string(p.ID)
+	if p.Type == URITypeUser {
+		id = url.PathEscape(id)
+	}
+	return URI("spotify:" + string(p.Type) + ":" + id)
+}
+
+// Link returns the open.spotify.com URL of the item.
+func (p ParsedURI) Link() string {
+	return "https://open.spotify.com/" + string(p.Type) + "/" + url.PathEscape(string(p.ID))
+}
+
+// ParseURI parses a Spotify URI such as spotify:track:6rqhFgbbKwnb9MLmUQDhG6.
+// User URIs, such as spotify:user:wizzler, and the legacy playlist URIs that
+// include the owner, such as spotify:user:wizzler:playlist:<id>, are also
+// accepted.
+//
+// IDs must be base-62, except for user IDs.  An error matching
+// [ErrInvalidURI] is returned if s isn't a valid URI.
+func ParseURI(s string) (ParsedURI, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 3 || parts[0] != "spotify" {
+		return ParsedURI{}, fmt.Errorf("%w %q", ErrInvalidURI, s)
+	}
+	parts = parts[1:]
+	// spotify:user:<user>:playlist:<id>
+	if len(parts) == 4 && parts[0] == string(URITypeUser) && parts[2] == string(URITypePlaylist) {
+		parts = parts[2:]
+	}
+	if len(parts) != 2 {
+		return ParsedURI{}, fmt.Errorf("%w %q", ErrInvalidURI, s)
+	}
+	id := parts[1]
+	if URIType(parts[0]) == URITypeUser {
+		if unescaped, err := url.PathUnescape(id); err == nil {
+			id = unescaped
+		}
+	}
+	return newParsedURI(s, parts[0], id)
+}
+
+// ParseLink parses an open.spotify.com URL, such as
+// https://open.spotify.com/track/6rqhFgbbKwnb9MLmUQDhG6?si=abc, as well as
+// anything accepted by [ParseURI], which makes it suitable for input pasted
+// by users.  The scheme may be omitted.  Localized links, such as
+// https://open.spotify.com/intl-de/track/<id>, embed links and query
+// parameters, such as the si tracking parameter, are accepted.
+//
+// IDs must be base-62, except for user IDs.  An error matching
+// [ErrInvalidURI] is returned if s isn't a valid link or URI.
+func ParseLink(s string) (ParsedURI, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "spotify:") {
+		return ParseURI(s)
+	}
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host != "open.spotify.com" {
+		return ParsedURI{}, fmt.Errorf("%w %q", ErrInvalidURI, s)
+	}
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	if len(segments) > 0 && strings.HasPrefix(segments[0], "intl-") {
+		segments = segments[1:]
+	}
+	if len(segments) > 0 && segments[0] == "embed" {
+		segments = segments[1:]
+	}
+	// /user/<user>/playlist/<id>
+	if len(segments) == 4 && segments[0] == string(URITypeUser) && segments[2] == string(URITypePlaylist) {
+		segments = segments[2:]
+	}
+	if len(segments) != 2 {
+		return ParsedURI{}, fmt.Errorf("%w %q", ErrInvalidURI, s)
+	}
+	id, err := url.PathUnescape(segments[1])
+	if err != nil {
+		return ParsedURI{}, fmt.Errorf("%w %q", ErrInvalidURI, s)
+	}
+	return newParsedURI(s, segments[0], id)
+}
+
+func newParsedURI(s, typ, id string) (ParsedURI, error) {
+	t := URIType(typ)
+	switch {
+	case !uriTypes[t]:
+		return ParsedURI{}, fmt.Errorf("%w %q: unknown type %q", ErrInvalidURI, s, typ)
+	case id == "":
+		return ParsedURI{}, fmt.Errorf("%w %q: missing ID", ErrInvalidURI, s)
+	case t != URITypeUser && !isBase62(id):
+		return ParsedURI{}, fmt.Errorf("%w %q: ID is not base-62", ErrInvalidURI, s)
+	}
+	return ParsedURI{Type: t, ID: ID(id)}, nil
+}
+
+func isBase62(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// Type returns the type of the item that u identifies, or the empty string
+// if u isn't a valid URI.
+func (u URI) Type() URIType {
+	p, _ := ParseURI(string(u))
+	return p.Type
+}
+
+// ID returns the ID of the item that u identifies, or the empty string if u
+// isn't a valid URI.
+func (u URI) ID() ID {
+	p, _ := ParseURI(string(u))
+	return p.ID
+}
+
+// TrackURI returns the URI of the track with the given ID.
+func TrackURI(id ID) URI { return ParsedURI{URITypeTrack, id}.URI() }
+
+// AlbumURI returns the URI of the album with the given ID.
+func AlbumURI(id ID) URI { return ParsedURI{URITypeAlbum, id}.URI() }
+
+// ArtistURI returns the URI of the artist with the given ID.
+func ArtistURI(id ID) URI { return ParsedURI{URITypeArtist, id}.URI() }
+
+// PlaylistURI returns the URI of the playlist with the given ID.
+func PlaylistURI(id ID) URI { return ParsedURI{URITypePlaylist, id}.URI() }
+
+// ShowURI returns the URI of the show with the given ID.
+func ShowURI(id ID) URI { return ParsedURI{URITypeShow, id}.URI() }
+
+// EpisodeURI returns the URI of the episode with the given ID.
+func EpisodeURI(id ID) URI { return ParsedURI{URITypeEpisode, id}.URI() }
+
+// AudiobookURI returns the URI of the audiobook with the given ID.
+func AudiobookURI(id ID) URI { return ParsedURI{URITypeAudiobook, id}.URI() }
+
+// ChapterURI returns the URI of the audiobook chapter with the given ID.
+func ChapterURI(id ID) URI { return ParsedURI{URITypeChapter, id}.URI() }
+
+// UserURI returns the URI of the user with the given ID.
+func UserURI(id ID) URI { return ParsedURI{URITypeUser, id}.URI() }
@@ -0,0 +1,107 @@
+package spotify
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ParsedURI
+	}{
+		{"spotify:track:6rqhFgbbKwnb9MLmUQDhG6", ParsedURI{URITypeTrack, "6rqhFgbbKwnb9MLmUQDhG6"}},
+		{"spotify:episode:512ojhOuo1ktJprKbVcKyQ", ParsedURI{URITypeEpisode, "512ojhOuo1ktJprKbVcKyQ"}},
+		{"spotify:user:wizzler", ParsedURI{URITypeUser, "wizzler"}},
+		{"spotify:user:john.doe%40example", ParsedURI{URITypeUser, "john.doe@example"}},
+		{"spotify:user:wizzler:playlist:37i9dQZF1DXcBWIGoYBM5M", ParsedURI{URITypePlaylist, "37i9dQZF1DXcBWIGoYBM5M"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseURI(tt.in)
+		if err != nil {
+			t.Errorf("ParseURI(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseURI(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"6rqhFgbbKwnb9MLmUQDhG6",
+		"spotify:track:",
+		"spotify:track:6rqh-gbbKwnb9MLmUQDhG6",
+		"spotify:genre:rock",
+		"spotify:track:6rqhFgbbKwnb9MLmUQDhG6:extra",
+		"https://open.spotify.com/track/6rqhFgbbKwnb9MLmUQDhG6",
+	} {
+		if _, err := ParseURI(in); !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("ParseURI(%q): expected ErrInvalidURI, got %v", in, err)
+		}
+	}
+}
+
+func TestParseLink(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ParsedURI
+	}{
+		{"https://open.spotify.com/track/6rqhFgbbKwnb9MLmUQDhG6", ParsedURI{URITypeTrack, "6rqhFgbbKwnb9MLmUQDhG6"}},
+		{"https://open.spotify.com/intl-de/album/0sNOF9WDwhWunNAHPD3Baj?si=a1b2c3", ParsedURI{URITypeAlbum, "0sNOF9WDwhWunNAHPD3Baj"}},
+		{"open.spotify.com/playlist/37i9dQZF1DXcBWIGoYBM5M/", ParsedURI{URITypePlaylist, "37i9dQZF1DXcBWIGoYBM5M"}},
+		{"  https://open.spotify.com/embed/show/38bS44xjbVVZ3No3ByF1dJ\n", ParsedURI{URITypeShow, "38bS44xjbVVZ3No3ByF1dJ"}},
+		{"https://open.spotify.com/user/wizzler/playlist/37i9dQZF1DXcBWIGoYBM5M", ParsedURI{URITypePlaylist, "37i9dQZF1DXcBWIGoYBM5M"}},
+		{"https://open.spotify.com/user/john.doe?si=x", ParsedURI{URITypeUser, "john.doe"}},
+		{"spotify:chapter:0D5wENdkdwbqlrHoaJ9g29", ParsedURI{URITypeChapter, "0D5wENdkdwbqlrHoaJ9g29"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseLink(tt.in)
+		if err != nil {
+			t.Errorf("ParseLink(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseLink(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{
+		"https://example.com/track/6rqhFgbbKwnb9MLmUQDhG6",
+		"ftp://open.spotify.com/track/6rqhFgbbKwnb9MLmUQDhG6",
+		"https://open.spotify.com/track",
+		"https://open.spotify.com/track/6rqhFgbbKwnb9MLmUQDhG6/extra",
+		"https://open.spotify.com/genre/rock",
+		"https://open.spotify.com/track/not%20base62",
+	} {
+		if _, err := ParseLink(in); !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("ParseLink(%q): expected ErrInvalidURI, got %v", in, err)
+		}
+	}
+}
+
+func TestURIConstruction(t *testing.T) {
+	if got := TrackURI("6rqhFgbbKwnb9MLmUQDhG6"); got != "spotify:track:6rqhFgbbKwnb9MLmUQDhG6" {
+		t.Errorf("Unexpected track URI %s", got)
+	}
+	if got := EpisodeURI("512ojhOuo1ktJprKbVcKyQ"); got.Type() != URITypeEpisode || got.ID() != "512ojhOuo1ktJprKbVcKyQ" {
+		t.Errorf("Unexpected episode URI %s", got)
+	}
+	user := UserURI("john.doe@example")
+	if user != "spotify:user:john.doe@example" {
+		t.Errorf("Unexpected user URI %s", user)
+	}
+	if user.ID() != "john.doe@example" {
+		t.Errorf("Expected the user ID to round trip, got %s", user.ID())
+	}
+	if URI("not a uri").Type() != "" || URI("not a uri").ID() != "" {
+		t.Error("Expected no type or ID for an invalid URI")
+	}
+	p := ParsedURI{URITypeAlbum, "0sNOF9WDwhWunNAHPD3Baj"}
+	if got := p.Link(); got != "https://open.spotify.com/album/0sNOF9WDwhWunNAHPD3Baj" {
+		t.Errorf("Unexpected link %s", got)
+	}
+	if back, err := ParseLink(p.Link()); err != nil || back != p {
+		t.Errorf("Expected the link to round trip, got %+v, %v", back, err)
+	}
+}
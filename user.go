@@ -2,6 +2,11 @@ package spotify
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 )
 
 // User contains the basic, publicly available information about a Spotify user.
@@ -14,6 +19,9 @@ type User struct {
 	ExternalURLs map[string]string `json:"external_urls"`
 	// A link to the Web API endpoint for this user.
 	Endpoint string `json:"href"`
+	// Information about the followers of the user.  This field is only
+	// populated when the user's profile is requested.
+	Followers Followers `json:"followers"`
 	// The Spotify user ID for the user.
 	ID string `json:"id"`
 	// The user's profile image.
@@ -33,6 +41,24 @@ type PrivateUser struct {
 	Birthdate string `json:"birthdate"`
 }
 
+// GetUsersPublicProfile gets [public profile information] about a
+// Spotify User.  It does not require authentication.
+//
+// [public profile information]: https://developer.spotify.com/documentation/web-api/reference/get-users-profile
+func (c *Client) GetUsersPublicProfile(ctx context.Context, userID ID) (*User, error) {
+	ctx = c.withOperation(ctx, "GetUsersPublicProfile")
+	spotifyURL := c.baseURL + "users/" + url.PathEscape(string(userID))
+
+	var user User
+
+	err := c.get(ctx, spotifyURL, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 // CurrentUser gets detailed profile information about the
 // [current user].
 //
@@ -48,6 +74,7 @@ type PrivateUser struct {
 //
 // [current user]: https://developer.spotify.com/documentation/web-api/reference/get-current-users-profile
 func (c *Client) CurrentUser(ctx context.Context) (*PrivateUser, error) {
+	ctx = c.withOperation(ctx, "CurrentUser")
 	var result PrivateUser
 
 	err := c.get(ctx, c.baseURL+"me", &result)
@@ -58,6 +85,31 @@ func (c *Client) CurrentUser(ctx context.Context) (*PrivateUser, error) {
 	return &result, nil
 }
 
+// CurrentUsersEpisodes gets a [list of episodes] saved in the current
+// Spotify user's library.  Further pages can be fetched with
+// [Client.NextPage].  This call requires [ScopeUserLibraryRead] and
+// [ScopeUserReadPlaybackPosition].
+//
+// Supported options: [Market], [Limit], [Offset].
+//
+// [list of episodes]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-episodes
+func (c *Client) CurrentUsersEpisodes(ctx context.Context, opts ...RequestOption) (*SavedEpisodePage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersEpisodes")
+	spotifyURL := c.baseURL + "me/episodes"
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result SavedEpisodePage
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 // CurrentUsersShows gets a [list of shows] saved in the current
 // Spotify user's "Your Music" library.
 //
@@ -65,6 +117,7 @@ func (c *Client) CurrentUser(ctx context.Context) (*PrivateUser, error) {
 //
 // [list of shows]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-shows
 func (c *Client) CurrentUsersShows(ctx context.Context, opts ...RequestOption) (*SavedShowPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersShows")
 	spotifyURL := c.baseURL + "me/shows"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -72,7 +125,31 @@ func (c *Client) CurrentUsersShows(ctx context.Context, opts ...RequestOption) (
 
 	var result SavedShowPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CurrentUsersAudiobooks gets a [list of audiobooks] saved in the current
+// Spotify user's library.  Further pages can be fetched with
+// [Client.NextPage].  This call requires [ScopeUserLibraryRead].
+//
+// Supported options: [Limit], [Offset].
+//
+// [list of audiobooks]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-audiobooks
+func (c *Client) CurrentUsersAudiobooks(ctx context.Context, opts ...RequestOption) (*SimpleAudiobookPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersAudiobooks")
+	spotifyURL := c.baseURL + "me/audiobooks"
+	if params := processOptions(opts...).urlParams.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+
+	var result SimpleAudiobookPage
+
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +164,7 @@ func (c *Client) CurrentUsersShows(ctx context.Context, opts ...RequestOption) (
 //
 // [list of songs]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-tracks
 func (c *Client) CurrentUsersTracks(ctx context.Context, opts ...RequestOption) (*SavedTrackPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersTracks")
 	spotifyURL := c.baseURL + "me/tracks"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -94,7 +172,7 @@ func (c *Client) CurrentUsersTracks(ctx context.Context, opts ...RequestOption)
 
 	var result SavedTrackPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +180,130 @@ func (c *Client) CurrentUsersTracks(ctx context.Context, opts ...RequestOption)
 	return &result, nil
 }
 
+// maxFollowIDs is the maximum number of IDs accepted by the follow endpoints.
+const maxFollowIDs = 50
+
+// FollowUser [adds the current user as a follower] of one or more Spotify
+// users, identified by their Spotify IDs.  Any number of IDs may be given;
+// they are sent 50 at a time.
+//
+// Modifying the lists of artists or users the current user follows
+// requires that the application has the [ScopeUserFollowModify] scope.
+//
+// [adds the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/follow-artists-users
+func (c *Client) FollowUser(ctx context.Context, ids ...ID) error {
+	ctx = c.withOperation(ctx, "FollowUser")
+	return c.modifyFollowers(ctx, "user", true, ids...)
+}
+
+// FollowArtist [adds the current user as a follower] of one or more Spotify
+// artists, identified by their Spotify IDs.  Any number of IDs may be given;
+// they are sent 50 at a time.
+//
+// Modifying the lists of artists or users the current user follows
+// requires that the application has the [ScopeUserFollowModify] scope.
+//
+// [adds the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/follow-artists-users
+func (c *Client) FollowArtist(ctx context.Context, ids ...ID) error {
+	ctx = c.withOperation(ctx, "FollowArtist")
+	return c.modifyFollowers(ctx, "artist", true, ids...)
+}
+
+// UnfollowUser [removes the current user as a follower] of one or more
+// Spotify users.  Any number of IDs may be given; they are sent 50 at a time.
+//
+// Modifying the lists of artists or users the current user follows
+// requires that the application has the [ScopeUserFollowModify] scope.
+//
+// [removes the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/unfollow-artists-users
+func (c *Client) UnfollowUser(ctx context.Context, ids ...ID) error {
+	ctx = c.withOperation(ctx, "UnfollowUser")
+	return c.modifyFollowers(ctx, "user", false, ids...)
+}
+
+// UnfollowArtist [removes the current user as a follower] of one or more
+// Spotify artists.  Any number of IDs may be given; they are sent 50 at a
+// time.
+//
+// Modifying the lists of artists or users the current user follows
+// requires that the application has the [ScopeUserFollowModify] scope.
+//
+// [removes the current user as a follower]: https://developer.spotify.com/documentation/web-api/reference/unfollow-artists-users
+func (c *Client) UnfollowArtist(ctx context.Context, ids ...ID) error {
+	ctx = c.withOperation(ctx, "UnfollowArtist")
+	return c.modifyFollowers(ctx, "artist", false, ids...)
+}
+
+// CurrentUserFollows [checks to see if the current user is following]
+// one or more artists or other Spotify users.  This call requires
+// [ScopeUserFollowRead].
+//
+// The t argument indicates the type of the IDs, and must be either
+// "user" or "artist".  Any number of IDs may be given; they are checked
+// 50 at a time.
+//
+// The result is returned as a slice of bool values in the same order
+// in which the IDs were specified.
+//
+// [checks to see if the current user is following]: https://developer.spotify.com/documentation/web-api/reference/check-current-user-follows
+func (c *Client) CurrentUserFollows(ctx context.Context, t string, ids ...ID) ([]bool, error) {
+	ctx = c.withOperation(ctx, "CurrentUserFollows")
+	if len(ids) == 0 {
+		return nil, errors.New("spotify: at least one ID is required")
+	}
+	if t != "artist" && t != "user" {
+		return nil, errors.New("spotify: t must be 'artist' or 'user'")
+	}
+
+	result := make([]bool, 0, len(ids))
+	for start := 0; start < len(ids); start += maxFollowIDs {
+		chunk := ids[start:min(start+maxFollowIDs, len(ids))]
+		spotifyURL := fmt.Sprintf("%sme/following/contains?type=%s&ids=%s",
+			c.baseURL, t, strings.Join(toStringSlice(chunk), ","))
+
+		var follows []bool
+		err := c.get(ctx, spotifyURL, &follows)
+		if err != nil {
+			return nil, err
+		}
+		if len(follows) != len(chunk) {
+			return nil, fmt.Errorf("spotify: expected %d results, got %d", len(chunk), len(follows))
+		}
+		result = append(result, follows...)
+	}
+
+	return result, nil
+}
+
+func (c *Client) modifyFollowers(ctx context.Context, usertype string, follow bool, ids ...ID) error {
+	if len(ids) == 0 {
+		return errors.New("spotify: at least one ID is required")
+	}
+	method := "PUT"
+	if !follow {
+		method = "DELETE"
+	}
+
+	for start := 0; start < len(ids); start += maxFollowIDs {
+		chunk := ids[start:min(start+maxFollowIDs, len(ids))]
+		v := url.Values{}
+		v.Set("type", usertype)
+		v.Set("ids", strings.Join(toStringSlice(chunk), ","))
+		spotifyURL := c.baseURL + "me/following?" + v.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, method, spotifyURL, nil)
+		if err != nil {
+			return err
+		}
+		err = c.execute(req, nil, http.StatusNoContent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CurrentUsersFollowedArtists gets the [current user's followed artists].
 // This call requires that the user has granted the [ScopeUserFollowRead] scope.
 //
@@ -109,6 +311,7 @@ func (c *Client) CurrentUsersTracks(ctx context.Context, opts ...RequestOption)
 //
 // [current user's followed artists]: https://developer.spotify.com/documentation/web-api/reference/get-followed
 func (c *Client) CurrentUsersFollowedArtists(ctx context.Context, opts ...RequestOption) (*FullArtistCursorPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersFollowedArtists")
 	spotifyURL := c.baseURL + "me/following"
 	v := processOptions(opts...).urlParams
 	v.Set("type", "artist")
@@ -120,7 +323,7 @@ func (c *Client) CurrentUsersFollowedArtists(ctx context.Context, opts ...Reques
 		A FullArtistCursorPage `json:"artists"`
 	}
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +338,7 @@ func (c *Client) CurrentUsersFollowedArtists(ctx context.Context, opts ...Reques
 //
 // [list of albums]: https://developer.spotify.com/documentation/web-api/reference/get-users-saved-albums
 func (c *Client) CurrentUsersAlbums(ctx context.Context, opts ...RequestOption) (*SavedAlbumPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersAlbums")
 	spotifyURL := c.baseURL + "me/albums"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -142,7 +346,7 @@ func (c *Client) CurrentUsersAlbums(ctx context.Context, opts ...RequestOption)
 
 	var result SavedAlbumPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +366,7 @@ func (c *Client) CurrentUsersAlbums(ctx context.Context, opts ...RequestOption)
 //
 // [list of the playlists]: https://developer.spotify.com/documentation/web-api/reference/get-a-list-of-current-users-playlists
 func (c *Client) CurrentUsersPlaylists(ctx context.Context, opts ...RequestOption) (*SimplePlaylistPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersPlaylists")
 	spotifyURL := c.baseURL + "me/playlists"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -169,7 +374,7 @@ func (c *Client) CurrentUsersPlaylists(ctx context.Context, opts ...RequestOptio
 
 	var result SimplePlaylistPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +389,7 @@ func (c *Client) CurrentUsersPlaylists(ctx context.Context, opts ...RequestOptio
 //
 // [user's top artists]: https://developer.spotify.com/documentation/web-api/reference/get-users-top-artists-and-tracks
 func (c *Client) CurrentUsersTopArtists(ctx context.Context, opts ...RequestOption) (*FullArtistPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersTopArtists")
 	spotifyURL := c.baseURL + "me/top/artists"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -191,7 +397,7 @@ func (c *Client) CurrentUsersTopArtists(ctx context.Context, opts ...RequestOpti
 
 	var result FullArtistPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +413,7 @@ func (c *Client) CurrentUsersTopArtists(ctx context.Context, opts ...RequestOpti
 //
 // [user's top tracks]: https://developer.spotify.com/documentation/web-api/reference/get-users-top-artists-and-tracks
 func (c *Client) CurrentUsersTopTracks(ctx context.Context, opts ...RequestOption) (*FullTrackPage, error) {
+	ctx = c.withOperation(ctx, "CurrentUsersTopTracks")
 	spotifyURL := c.baseURL + "me/top/tracks"
 	if params := processOptions(opts...).urlParams.Encode(); params != "" {
 		spotifyURL += "?" + params
@@ -214,7 +421,7 @@ func (c *Client) CurrentUsersTopTracks(ctx context.Context, opts ...RequestOptio
 
 	var result FullTrackPage
 
-	err := c.get(ctx, spotifyURL, &result)
+	err := c.get(ctx, spotifyURL, &result, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2,8 +2,10 @@ package spotify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 )
@@ -34,6 +36,130 @@ func TestCurrentUser(t *testing.T) {
 	}
 }
 
+func TestGetUsersPublicProfile(t *testing.T) {
+	json := `{
+		"display_name" : "Ronald Pompa",
+		"external_urls" : {
+			"spotify" : "https://open.spotify.com/user/wizzler"
+		},
+		"followers" : {
+			"href" : null,
+			"total" : 3829
+		},
+		"href" : "https://api.spotify.com/v1/users/wizzler",
+		"id" : "wizzler",
+		"images" : [ {
+			"height" : null,
+			"url" : "http://profile-images.scdn.co/images/userprofile/default/9d51820e73667ea5f1e97ea601cf0593b558050e",
+			"width" : null
+		} ],
+		"type" : "user",
+		"uri" : "spotify:user:wizzler"
+	}`
+	client, server := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if r.URL.Path != "/users/wizzler" {
+			t.Errorf("Expected path /users/wizzler, got %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	user, err := client.GetUsersPublicProfile(context.Background(), "wizzler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "wizzler" {
+		t.Errorf("Expected user wizzler, got %s", user.ID)
+	}
+	if user.DisplayName != "Ronald Pompa" {
+		t.Errorf("Expected display name Ronald Pompa, got %s", user.DisplayName)
+	}
+	if user.Followers.Count != 3829 {
+		t.Errorf("Expected 3829 followers, got %d", user.Followers.Count)
+	}
+}
+
+func TestGetUsersPublicProfileNotFound(t *testing.T) {
+	client, server := testClientString(http.StatusNotFound, `{"error": {"status": 404, "message": "No such user"}}`)
+	defer server.Close()
+
+	user, err := client.GetUsersPublicProfile(context.Background(), "nobody")
+	if !errors.Is(err, ErrNotFound) || user != nil {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestCurrentUsersAudiobooks(t *testing.T) {
+	json := `{
+		"href": "https://api.spotify.com/v1/me/audiobooks?offset=0&limit=20",
+		"limit": 20,
+		"next": null,
+		"offset": 0,
+		"previous": null,
+		"total": 1,
+		"items": [{
+			"authors": [{"name": "Frank Herbert"}],
+			"id": "7iHfbu1YPACw6oZPAFJtqe",
+			"name": "Dune: Book One in the Dune Chronicles",
+			"total_chapters": 51,
+			"type": "audiobook",
+			"uri": "spotify:show:7iHfbu1YPACw6oZPAFJtqe"
+		}]
+	}`
+	client, server := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if r.URL.Path != "/me/audiobooks" {
+			t.Errorf("Expected path /me/audiobooks, got %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	books, err := client.CurrentUsersAudiobooks(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if books.Total != 1 || len(books.Audiobooks) != 1 {
+		t.Fatalf("Expected 1 audiobook, got %d", len(books.Audiobooks))
+	}
+	if books.Audiobooks[0].Authors[0].Name != "Frank Herbert" {
+		t.Errorf("Unexpected author %s", books.Audiobooks[0].Authors[0].Name)
+	}
+}
+
+func TestCurrentUsersEpisodes(t *testing.T) {
+	json := `{
+		"href": "https://api.spotify.com/v1/me/episodes?offset=0&limit=1",
+		"limit": 1,
+		"next": "https://api.spotify.com/v1/me/episodes?offset=1&limit=1",
+		"offset": 0,
+		"previous": null,
+		"total": 2,
+		"items": [{
+			"added_at": "2026-02-11T08:30:00Z",
+			"episode": {"id": "2DSKnz9Hqm1tKimcXqcMJD", "name": "112: Dirty Coms", "type": "episode", "show": {"name": "Darknet Diaries"}}
+		}]
+	}`
+	client, server := testClientString(http.StatusOK, json, func(r *http.Request) {
+		if r.URL.Path != "/me/episodes" {
+			t.Errorf("Expected path /me/episodes, got %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	page, err := client.CurrentUsersEpisodes(context.Background(), Limit(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 2 || len(page.Episodes) != 1 {
+		t.Fatalf("Unexpected page: total %d, %d episodes", page.Total, len(page.Episodes))
+	}
+	ep := page.Episodes[0]
+	if ep.AddedAt != "2026-02-11T08:30:00Z" {
+		t.Error("Invalid added at:", ep.AddedAt)
+	}
+	if ep.Name != "112: Dirty Coms" || ep.Show.Name != "Darknet Diaries" {
+		t.Error("Invalid episode:", ep.Name, ep.Show.Name)
+	}
+}
+
 func TestCurrentUsersTracks(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/current_users_tracks.txt")
 	defer server.Close()
@@ -219,6 +345,37 @@ func TestCurrentUsersFollowedArtistsOpt(t *testing.T) {
 	}
 }
 
+func TestCurrentUsersFollowedArtistsItems(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			fmt.Fprintf(w, `{"artists": {"items": [{"name": "one"}, {"name": "two"}], "next": "%s/me/following?type=artist&after=two", "cursors": {"after": "two"}}}`, server.URL)
+		case "two":
+			fmt.Fprint(w, `{"artists": {"items": [{"name": "three"}], "next": null, "cursors": {"after": null}}}`)
+		default:
+			t.Errorf("Unexpected cursor %q", after)
+		}
+	}))
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	page, err := client.CurrentUsersFollowedArtists(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for artist, err := range Items(context.Background(), client, page) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, artist.Name)
+	}
+	if got := strings.Join(names, ","); got != "one,two,three" {
+		t.Errorf("Expected one,two,three, got %s", got)
+	}
+}
+
 func TestCurrentUsersTopArtists(t *testing.T) {
 	client, server := testClientFile(http.StatusOK, "test_data/current_users_top_artists.txt")
 	defer server.Close()
@@ -278,3 +435,68 @@ func TestCurrentUsersTopTracks(t *testing.T) {
 		fmt.Printf("\n%#v\n", tracks.Tracks[0])
 	}
 }
+
+func TestFollowArtist(t *testing.T) {
+	client, server := testClientString(http.StatusNoContent, "", func(r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/me/following" || r.URL.Query().Get("type") != "artist" || r.URL.Query().Get("ids") != "74ASZWbe4lXaubB36ztrGX,08td7MxkoHQkXnWAYD8d6Q" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+	defer server.Close()
+
+	if err := client.FollowArtist(context.Background(), "74ASZWbe4lXaubB36ztrGX", "08td7MxkoHQkXnWAYD8d6Q"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnfollowUser(t *testing.T) {
+	client, server := testClientString(http.StatusNoContent, "", func(r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Query().Get("type") != "user" || r.URL.Query().Get("ids") != "exampleuser01" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	defer server.Close()
+
+	if err := client.UnfollowUser(context.Background(), "exampleuser01"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCurrentUserFollows(t *testing.T) {
+	var requests []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		requests = append(requests, len(ids))
+		follows := make([]string, len(ids))
+		for i, id := range ids {
+			follows[i] = fmt.Sprint(id == "artist7" || id == "artist55")
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(follows, ","))
+	}))
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	ids := make([]ID, 60)
+	for i := range ids {
+		ids[i] = ID(fmt.Sprintf("artist%d", i))
+	}
+	follows, err := client.CurrentUserFollows(context.Background(), "artist", ids...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 || requests[0] != 50 || requests[1] != 10 {
+		t.Errorf("Expected requests for 50 and 10 IDs, got %v", requests)
+	}
+	for i, follows := range follows {
+		if want := i == 7 || i == 55; follows != want {
+			t.Errorf("Expected %t for %s, got %t", want, ids[i], follows)
+		}
+	}
+
+	if _, err := client.CurrentUserFollows(context.Background(), "playlist", "x"); err == nil {
+		t.Error("Expected an error for an invalid type")
+	}
+}
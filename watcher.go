@@ -0,0 +1,277 @@
+package spotify
+
+import (
+	"context"
+	"time"
+)
+
+// PlayerEventType identifies the kind of change reported by a [PlayerEvent].
+type PlayerEventType int
+
+// PlayerEventType values reported by a [PlayerWatcher].
+const (
+	// PlayerEventTrackChanged is reported when a different item is loaded,
+	// including when playback stops entirely.
+	PlayerEventTrackChanged PlayerEventType = iota + 1
+	// PlayerEventPaused is reported when playback is paused.
+	PlayerEventPaused
+	// PlayerEventResumed is reported when playback is resumed.
+	PlayerEventResumed
+	// PlayerEventSeeked is reported when the progress into the current item
+	// jumps rather than advancing with time.
+	PlayerEventSeeked
+	// PlayerEventDeviceChanged is reported when playback moves to a
+	// different device.
+	PlayerEventDeviceChanged
+	// PlayerEventVolumeChanged is reported when the volume of the active
+	// device changes.
+	PlayerEventVolumeChanged
+	// PlayerEventShuffleChanged is reported when shuffle or smart shuffle is
+	// turned on or off.
+	PlayerEventShuffleChanged
+	// PlayerEventRepeatChanged is reported when the repeat mode changes.
+	PlayerEventRepeatChanged
+	// PlayerEventContextChanged is reported when playback switches to a
+	// different album, artist, playlist or show.
+	PlayerEventContextChanged
+	// PlayerEventError is reported when the player state can't be read.
+	// The watcher keeps polling.
+	PlayerEventError
+)
+
+var playerEventNames = map[PlayerEventType]string{
+	PlayerEventTrackChanged:   "TrackChanged",
+	PlayerEventPaused:         "Paused",
+	PlayerEventResumed:        "Resumed",
+	PlayerEventSeeked:         "Seeked",
+	PlayerEventDeviceChanged:  "DeviceChanged",
+	PlayerEventVolumeChanged:  "VolumeChanged",
+	PlayerEventShuffleChanged: "ShuffleChanged",
+	PlayerEventRepeatChanged:  "RepeatChanged",
+	PlayerEventContextChanged: "ContextChanged",
+	PlayerEventError:          "Error",
+}
+
+func (t PlayerEventType) String() string {
+	if name, ok := playerEventNames[t]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// PlayerEvent is a change in the user's playback reported by a
+// [PlayerWatcher].
+type PlayerEvent struct {
+	Type PlayerEventType
+	// Previous is the state before the change.  It is nil for changes
+	// reported by the first poll.
+	Previous *PlayerState
+	// Current is the state after the change.  It is nil for errors.
+	Current *PlayerState
+	// Err is the error for [PlayerEventError] events.
+	Err error
+}
+
+// WatchOption is an option for [NewPlayerWatcher].
+type WatchOption func(*PlayerWatcher)
+
+// WatchInterval sets how often the player state is polled.  The default is
+// every 5 seconds; intervals shorter than a second are raised to a second.
+func WatchInterval(d time.Duration) WatchOption {
+	return func(w *PlayerWatcher) {
+		w.interval = d
+	}
+}
+
+// WatchFixedInterval polls at exactly the configured interval.  By default,
+// the watcher polls sooner when the current item is about to end, so that
+// track changes are reported promptly.
+func WatchFixedInterval() WatchOption {
+	return func(w *PlayerWatcher) {
+		w.fixed = true
+	}
+}
+
+// WatchCallback delivers events by calling f instead of sending them on the
+// channel returned by [PlayerWatcher.Events].  f is called from the
+// goroutine running [PlayerWatcher.Run]; polling waits for it to return.
+func WatchCallback(f func(PlayerEvent)) WatchOption {
+	return func(w *PlayerWatcher) {
+		w.callback = f
+	}
+}
+
+const (
+	defaultWatchInterval = 5 * time.Second
+	minWatchInterval     = time.Second
+	// trackEndSlack is how long after the expected end of an item the
+	// watcher polls, to give the player time to load the next one.
+	trackEndSlack = 500 * time.Millisecond
+	// seekTolerance is how far progress may drift from the expected value
+	// before it's reported as a seek.
+	seekTolerance = 2 * time.Second
+)
+
+// PlayerWatcher polls the user's playback state and reports changes as
+// [PlayerEvent]s.  Create one with [NewPlayerWatcher], start it with
+// [PlayerWatcher.Run] and receive events from [PlayerWatcher.Events] or a
+// [WatchCallback].
+//
+// Episodes are included in the playback state.  Reading it requires the
+// [ScopeUserReadPlaybackState] scope.
+type PlayerWatcher struct {
+	client   *Client
+	interval time.Duration
+	fixed    bool
+	callback func(PlayerEvent)
+	events   chan PlayerEvent
+	// onState, if set, is called with every state that is read and the
+	// time it was read at, before any events for it are delivered.
+	onState func(*PlayerState, time.Time)
+}
+
+// NewPlayerWatcher creates a watcher for the playback of the user that
+// client is authorized for.
+func NewPlayerWatcher(client *Client, opts ...WatchOption) *PlayerWatcher {
+	w := &PlayerWatcher{
+		client:   client,
+		interval: defaultWatchInterval,
+		events:   make(chan PlayerEvent, 16),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.interval = max(w.interval, minWatchInterval)
+	return w
+}
+
+// Events returns the channel on which events are delivered, unless a
+// [WatchCallback] is set.  Polling waits while the channel is full, so it
+// should be drained promptly.  The channel is closed when
+// [PlayerWatcher.Run] returns.
+func (w *PlayerWatcher) Events() <-chan PlayerEvent {
+	return w.events
+}
+
+// Run polls the player state until ctx is done.  It must be called only
+// once.
+//
+// The first poll establishes the initial state; only a loaded item is
+// reported, as a [PlayerEventTrackChanged] event without a previous state.
+// Errors are reported as [PlayerEventError] events and polling continues.
+func (w *PlayerWatcher) Run(ctx context.Context) {
+	defer close(w.events)
+
+	var (
+		prev   *PlayerState
+		prevAt time.Time
+	)
+	for {
+		state, err := w.client.PlayerState(ctx, AdditionalTypes(TrackAdditionalType, EpisodeAdditionalType), BypassCache())
+		now := time.Now()
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			w.emit(ctx, PlayerEvent{Type: PlayerEventError, Previous: prev, Err: err})
+		default:
+			if w.onState != nil {
+				w.onState(state, now)
+			}
+			for _, typ := range playerChanges(prev, state, now.Sub(prevAt)) {
+				w.emit(ctx, PlayerEvent{Type: typ, Previous: prev, Current: state})
+			}
+			prev, prevAt = state, now
+		}
+
+		timer := time.NewTimer(w.next(prev))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (w *PlayerWatcher) emit(ctx context.Context, e PlayerEvent) {
+	if w.callback != nil {
+		w.callback(e)
+		return
+	}
+	select {
+	case w.events <- e:
+	case <-ctx.Done():
+	}
+}
+
+// next returns how long to wait before polling again, given the last known
+// state.
+func (w *PlayerWatcher) next(s *PlayerState) time.Duration {
+	d := w.interval
+	if w.fixed || s == nil || !s.Playing || s.Item == nil {
+		return d
+	}
+	remaining := s.Item.Duration() - time.Duration(s.Progress)*time.Millisecond
+	if remaining+trackEndSlack < d {
+		d = max(remaining+trackEndSlack, minWatchInterval)
+	}
+	return d
+}
+
+// playerChanges returns the changes from prev to cur, which was read elapsed
+// after prev.  prev is nil for the first state.
+func playerChanges(prev, cur *PlayerState, elapsed time.Duration) []PlayerEventType {
+	if prev == nil {
+		if cur.Item != nil {
+			return []PlayerEventType{PlayerEventTrackChanged}
+		}
+		return nil
+	}
+
+	var changes []PlayerEventType
+	sameItem := itemURI(prev.Item) == itemURI(cur.Item)
+	if !sameItem {
+		changes = append(changes, PlayerEventTrackChanged)
+	}
+	if prev.Playing && !cur.Playing {
+		changes = append(changes, PlayerEventPaused)
+	}
+	if !prev.Playing && cur.Playing {
+		changes = append(changes, PlayerEventResumed)
+	}
+	if sameItem && cur.Item != nil {
+		expected := time.Duration(prev.Progress) * time.Millisecond
+		if prev.Playing {
+			expected += elapsed
+		}
+		drift := time.Duration(cur.Progress)*time.Millisecond - expected
+		if drift > seekTolerance || drift < -seekTolerance {
+			changes = append(changes, PlayerEventSeeked)
+		}
+	}
+	sameDevice := prev.Device.ID == cur.Device.ID && prev.Device.Name == cur.Device.Name
+	if !sameDevice {
+		changes = append(changes, PlayerEventDeviceChanged)
+	}
+	if sameDevice && prev.Device.Volume != cur.Device.Volume {
+		changes = append(changes, PlayerEventVolumeChanged)
+	}
+	if prev.ShuffleState != cur.ShuffleState || prev.SmartShuffle != cur.SmartShuffle {
+		changes = append(changes, PlayerEventShuffleChanged)
+	}
+	if prev.RepeatState != cur.RepeatState {
+		changes = append(changes, PlayerEventRepeatChanged)
+	}
+	if prev.PlaybackContext.URI != cur.PlaybackContext.URI {
+		changes = append(changes, PlayerEventContextChanged)
+	}
+	return changes
+}
+
+func itemURI(item *PlayableItem) URI {
+	if item == nil {
+		return ""
+	}
+	return item.URI()
+}
@@ -0,0 +1,202 @@
+package spotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// playerStateJSON returns the playback state of a 200 second track.
+func playerStateJSON(track string, playing bool, progress int, device string, volume int, shuffle bool, repeat string, context string) string {
+	return fmt.Sprintf(`{
+		"device": {"id": %q, "name": %q, "volume_percent": %d},
+		"shuffle_state": %t,
+		"repeat_state": %q,
+		"context": {"uri": %q},
+		"progress_ms": %d,
+		"is_playing": %t,
+		"currently_playing_type": "track",
+		"item": {"type": "track", "uri": "spotify:track:%s", "duration_ms": 200000}
+	}`, device, device, volume, shuffle, repeat, context, progress, playing, track)
+}
+
+// sequenceServer serves each of states in turn in response to requests
+// for the player state, repeating the last one.
+func sequenceServer(t *testing.T, states []string) *httptest.Server {
+	var (
+		mu sync.Mutex
+		n  int
+	)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/me/player" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("additional_types"); got != "track,episode" {
+			t.Errorf("Expected additional_types track,episode, got %s", got)
+		}
+		mu.Lock()
+		state := states[min(n, len(states)-1)]
+		n++
+		mu.Unlock()
+		if state == "" {
+			http.Error(w, `{"error": {"status": 500, "message": "Server error"}}`, http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(state))
+	}))
+}
+
+func TestPlayerWatcher(t *testing.T) {
+	server := sequenceServer(t, []string{
+		playerStateJSON("a", true, 1000, "d1", 50, false, "off", "c1"),
+		playerStateJSON("a", false, 1000, "d1", 50, false, "off", "c1"),
+		playerStateJSON("a", true, 1000, "d1", 50, false, "off", "c1"),
+		playerStateJSON("a", true, 90000, "d1", 50, false, "off", "c1"),
+		playerStateJSON("b", true, 0, "d1", 50, false, "off", "c2"),
+		playerStateJSON("b", true, 0, "d1", 80, false, "off", "c2"),
+		playerStateJSON("b", true, 0, "d2", 80, false, "off", "c2"),
+		playerStateJSON("b", true, 0, "d2", 80, true, "context", "c2"),
+	})
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := NewPlayerWatcher(client, WatchFixedInterval())
+	w.interval = 5 * time.Millisecond
+	go w.Run(ctx)
+
+	want := []PlayerEventType{
+		PlayerEventTrackChanged,
+		PlayerEventPaused,
+		PlayerEventResumed,
+		PlayerEventSeeked,
+		PlayerEventTrackChanged, PlayerEventContextChanged,
+		PlayerEventVolumeChanged,
+		PlayerEventDeviceChanged,
+		PlayerEventShuffleChanged, PlayerEventRepeatChanged,
+	}
+	var got []string
+	for len(got) < len(want) {
+		select {
+		case e := <-w.Events():
+			got = append(got, e.Type.String())
+			if e.Type == PlayerEventTrackChanged && e.Current.Item.URI() == "spotify:track:b" &&
+				e.Previous.Item.URI() != "spotify:track:a" {
+				t.Errorf("Expected the previous track to be a, got %s", e.Previous.Item.URI())
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out after events %v", got)
+		}
+	}
+	var wantNames []string
+	for _, typ := range want {
+		wantNames = append(wantNames, typ.String())
+	}
+	if strings.Join(got, ",") != strings.Join(wantNames, ",") {
+		t.Errorf("Expected events %v, got %v", wantNames, got)
+	}
+
+	cancel()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case e, ok := <-w.Events():
+			if !ok {
+				return
+			}
+			t.Errorf("Unexpected event %s after the state settled", e.Type)
+		case <-timeout:
+			t.Fatal("Expected the events channel to be closed")
+		}
+	}
+}
+
+func TestPlayerWatcherErrors(t *testing.T) {
+	server := sequenceServer(t, []string{
+		playerStateJSON("a", true, 1000, "d1", 50, false, "off", "c1"),
+		"",
+		playerStateJSON("b", true, 0, "d1", 50, false, "off", "c1"),
+	})
+	defer server.Close()
+	client := &Client{http: http.DefaultClient, baseURL: server.URL + "/"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan PlayerEvent, 10)
+	w := NewPlayerWatcher(client, WatchCallback(func(e PlayerEvent) { events <- e }))
+	w.interval = 5 * time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	for _, want := range []PlayerEventType{PlayerEventTrackChanged, PlayerEventError, PlayerEventTrackChanged} {
+		select {
+		case e := <-events:
+			if e.Type != want {
+				t.Fatalf("Expected %s, got %s", want, e.Type)
+			}
+			if e.Type == PlayerEventError && !errors.Is(e.Err, ErrServerError) {
+				t.Errorf("Expected server error, got %v", e.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for %s", want)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Run to return after cancellation")
+	}
+}
+
+func TestPlayerWatcherMinInterval(t *testing.T) {
+	for _, d := range []time.Duration{-time.Second, 0, time.Millisecond} {
+		w := NewPlayerWatcher(&Client{}, WatchInterval(d), WatchFixedInterval())
+		if got := w.next(nil); got != minWatchInterval {
+			t.Errorf("WatchInterval(%s): expected polling every %s, got %s", d, minWatchInterval, got)
+		}
+	}
+}
+
+func TestPlayerWatcherAdaptiveInterval(t *testing.T) {
+	w := NewPlayerWatcher(&Client{}, WatchInterval(10*time.Second))
+	state := func(playing bool, progress Numeric) *PlayerState {
+		s := &PlayerState{}
+		s.Playing = playing
+		s.Progress = progress
+		s.Item = &PlayableItem{Track: &FullTrack{SimpleTrack: SimpleTrack{Duration: 200000}}}
+		return s
+	}
+
+	tests := []struct {
+		state *PlayerState
+		want  time.Duration
+	}{
+		{nil, 10 * time.Second},
+		{state(true, 100000), 10 * time.Second},
+		{state(true, 196000), 4500 * time.Millisecond},
+		{state(true, 199900), time.Second},
+		{state(false, 199900), 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := w.next(tt.state); got != tt.want {
+			t.Errorf("Expected %s, got %s", tt.want, got)
+		}
+	}
+
+	w = NewPlayerWatcher(&Client{}, WatchInterval(10*time.Second), WatchFixedInterval())
+	if got := w.next(state(true, 199900)); got != 10*time.Second {
+		t.Errorf("Expected a fixed interval, got %s", got)
+	}
+}